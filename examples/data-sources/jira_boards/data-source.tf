@@ -0,0 +1,10 @@
+# List the scrum boards of a project
+
+data "jira_boards" "payments" {
+  project_key_or_id = "PAY"
+  type              = "scrum"
+}
+
+output "payments_board_ids_by_name" {
+  value = { for id, b in data.jira_boards.payments.boards : b.name => id }
+}
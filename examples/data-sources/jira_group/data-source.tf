@@ -0,0 +1,5 @@
+# Look up an existing group by name to use its ID
+
+data "jira_group" "admins" {
+  name = "jira-administrators"
+}
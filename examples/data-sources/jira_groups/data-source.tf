@@ -0,0 +1,9 @@
+# List groups by name; the result is keyed by group name
+
+data "jira_groups" "selected" {
+  names = ["jira-administrators", "jira-software-users"]
+}
+
+output "administrators_group_id" {
+  value = data.jira_groups.selected.groups["jira-administrators"].id
+}
@@ -0,0 +1,9 @@
+# Look up issue link types by name, e.g. to reference the built-in "Blocks" type
+
+data "jira_issue_link_types" "by_name" {
+  names = ["Blocks", "Relates"]
+}
+
+output "link_type_ids_by_name" {
+  value = { for id, lt in data.jira_issue_link_types.by_name.link_types : lt.name => id }
+}
@@ -0,0 +1,5 @@
+# Minimal example: list the permission keys usable in permission scheme grants
+
+data "jira_permissions" "project" {
+  type = "PROJECT"
+}
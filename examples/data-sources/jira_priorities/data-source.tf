@@ -0,0 +1,7 @@
+# List all priorities from highest to lowest
+
+data "jira_priorities" "all" {}
+
+output "priority_names" {
+  value = [for p in data.jira_priorities.all.priorities : p.name]
+}
@@ -0,0 +1,7 @@
+# List all resolutions in the order Jira shows them
+
+data "jira_resolutions" "all" {}
+
+output "resolution_ids_by_name" {
+  value = { for r in data.jira_resolutions.all.resolutions : r.name => r.id }
+}
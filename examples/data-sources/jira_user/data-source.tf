@@ -0,0 +1,12 @@
+# Look up a user by email to use as project lead
+
+data "jira_user" "lead" {
+  email_address = "jane.doe@example.com"
+}
+
+resource "jira_project" "example" {
+  key              = "EXAMPLE"
+  name             = "Example"
+  project_type_key = "software"
+  lead_account_id  = data.jira_user.lead.account_id
+}
@@ -0,0 +1,10 @@
+# Search users by display name or email prefix; the result is keyed by account ID
+
+data "jira_users" "jane" {
+  query        = "Jane"
+  account_type = "atlassian"
+}
+
+output "jane_account_ids" {
+  value = keys(data.jira_users.jane.users)
+}
@@ -0,0 +1,5 @@
+# List every status in the DONE category
+
+data "jira_workflow_statuses" "done" {
+  status_category = "DONE"
+}
@@ -0,0 +1,6 @@
+# Look up workflow statuses by name
+# Note: The statuses attribute is a map keyed by status ID for stability across renames.
+
+data "jira_workflow_statuses" "review" {
+  names = ["In Review", "Ready for QA"]
+}
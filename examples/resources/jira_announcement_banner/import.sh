@@ -0,0 +1,9 @@
+#!/usr/bin/env bash
+# Import the announcement banner of the Jira site. A site has exactly one banner, imported with the ID announcement_banner.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_announcement_banner.example announcement_banner
+
+set -euo pipefail
+
+terraform import jira_announcement_banner.example announcement_banner
@@ -0,0 +1,5 @@
+resource "jira_announcement_banner" "maintenance" {
+  message     = "Jira will be read-only on Saturday from 06:00 to 08:00 UTC for maintenance."
+  dismissible = true
+  visibility  = "private"
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira application property by its key.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_application_property.example <PROPERTY_KEY>
+# Example:
+#   terraform import jira_application_property.example jira.clone.prefix
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <PROPERTY_KEY>" >&2
+  exit 1
+fi
+
+terraform import jira_application_property.example "$1"
@@ -0,0 +1,14 @@
+# Keep site-wide advanced settings the same on staging and production.
+locals {
+  advanced_settings = {
+    "jira.clone.prefix"        = "COPY -"
+    "jira.issue.actions.order" = "desc"
+  }
+}
+
+resource "jira_application_property" "advanced" {
+  for_each = local.advanced_settings
+
+  key   = each.key
+  value = each.value
+}
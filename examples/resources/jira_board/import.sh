@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira board by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_board.example <BOARD_ID>
+# Example:
+#   terraform import jira_board.example 42
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <BOARD_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_board.example "$1"
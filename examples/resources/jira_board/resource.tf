@@ -0,0 +1,33 @@
+# A project with its scrum board, created in one apply.
+resource "jira_project" "payments" {
+  key              = "PAY"
+  name             = "Payments"
+  project_type_key = "software"
+  lead_account_id  = "5b10a2844c20165700ede21g"
+}
+
+resource "jira_filter" "payments_board" {
+  name = "Payments board"
+  jql  = "project = ${jira_project.payments.key} ORDER BY Rank ASC"
+
+  share_permissions = [
+    {
+      type       = "project"
+      project_id = jira_project.payments.id
+    },
+  ]
+}
+
+resource "jira_board" "payments" {
+  name       = "Payments"
+  type       = "scrum"
+  filter_id  = jira_filter.payments_board.id
+  project_id = jira_project.payments.id
+
+  # Estimate in time instead of story points.
+  estimation_field_id = "timeoriginalestimate"
+}
+
+output "payments_board_columns" {
+  value = [for c in jira_board.payments.columns : c.name]
+}
@@ -0,0 +1,61 @@
+# One dashboard per squad project, stamped out from the projects whose name contains "Squad".
+data "jira_projects" "squads" {
+  type_keys = ["software"]
+  query     = "Squad"
+}
+
+resource "jira_filter" "open_work" {
+  for_each = data.jira_projects.squads.projects
+
+  name = "${each.value.name} - open work"
+  jql  = "project = ${each.value.key} AND resolution = Unresolved ORDER BY priority DESC"
+
+  share_permissions = [
+    {
+      type       = "project"
+      project_id = each.key
+    },
+  ]
+}
+
+resource "jira_dashboard" "squad" {
+  for_each = data.jira_projects.squads.projects
+
+  name        = "${each.value.name} dashboard"
+  description = "Team dashboard for ${each.value.name}."
+  layout      = "AB"
+
+  share_permissions = [
+    {
+      type       = "project"
+      project_id = each.key
+    },
+  ]
+
+  gadgets = [
+    {
+      module_key = "com.atlassian.jira.gadgets:filter-results-gadget"
+      title      = "Open work"
+      column     = 1
+      row        = 0
+      properties = jsonencode({
+        config = {
+          filterId    = jira_filter.open_work[each.key].id
+          num         = 20
+          columnNames = "issuetype|issuekey|summary|assignee|status"
+        }
+      })
+    },
+    {
+      module_key = "com.atlassian.jira.gadgets:assigned-to-me-gadget"
+      color      = "green"
+      column     = 0
+      row        = 0
+    },
+    {
+      module_key = "com.atlassian.streams.streams-jira-plugin:activitystream-gadget"
+      column     = 0
+      row        = 1
+    },
+  ]
+}
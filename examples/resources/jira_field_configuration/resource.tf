@@ -0,0 +1,22 @@
+resource "jira_field" "root_cause" {
+  name       = "Root cause"
+  field_type = "textarea"
+}
+
+resource "jira_field_configuration" "support" {
+  name        = "Support field configuration"
+  description = "Field behaviour for support work types"
+
+  fields = {
+    "environment" = {
+      required    = true
+      description = "Where the problem occurs"
+    }
+    "duedate" = {
+      hidden = true
+    }
+    (jira_field.root_cause.id) = {
+      renderer = "wiki-renderer"
+    }
+  }
+}
@@ -0,0 +1,27 @@
+resource "jira_field_configuration" "default" {
+  name = "Team field configuration"
+}
+
+resource "jira_field_configuration" "bugs" {
+  name = "Bug field configuration"
+
+  fields = {
+    "environment" = {
+      required = true
+    }
+  }
+}
+
+resource "jira_work_type" "bug" {
+  name = "Bug"
+}
+
+resource "jira_field_configuration_scheme" "team" {
+  name                           = "Team field configuration scheme"
+  description                    = "Stricter fields for bugs"
+  default_field_configuration_id = jira_field_configuration.default.id
+
+  work_type_field_configurations = {
+    (jira_work_type.bug.id) = jira_field_configuration.bugs.id
+  }
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a custom field context by the field ID and the numeric context ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_field_context.example <FIELD_ID>/<CONTEXT_ID>
+# Example:
+#   terraform import jira_field_context.example customfield_10050/10120
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <FIELD_ID>/<CONTEXT_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_field_context.example "$1"
@@ -0,0 +1,25 @@
+resource "jira_field" "severity" {
+  name       = "Severity"
+  field_type = "select"
+}
+
+resource "jira_field" "summary_hint" {
+  name       = "Summary hint"
+  field_type = "textfield"
+}
+
+# A context limited to one project and two work types.
+resource "jira_field_context" "severity" {
+  field_id      = jira_field.severity.id
+  name          = "Support severity"
+  description   = "Severity levels for support tickets"
+  project_ids   = ["10000"]
+  work_type_ids = ["10001", "10004"]
+}
+
+# A global context applying to any work type, with a default value.
+resource "jira_field_context" "summary_hint" {
+  field_id      = jira_field.summary_hint.id
+  name          = "Default hint"
+  default_value = "Describe the problem in one sentence"
+}
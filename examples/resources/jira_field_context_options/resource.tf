@@ -0,0 +1,35 @@
+resource "jira_field" "location" {
+  name       = "Location"
+  field_type = "cascadingselect"
+}
+
+resource "jira_field_context" "location" {
+  field_id = jira_field.location.id
+  name     = "Location"
+}
+
+resource "jira_field_context_options" "location" {
+  field_id   = jira_field.location.id
+  context_id = jira_field_context.location.id
+
+  options = [
+    {
+      value   = "Europe"
+      default = true
+      children = [
+        {
+          value   = "Berlin"
+          default = true
+        },
+        { value = "Lisbon" },
+      ]
+    },
+    {
+      value = "Americas"
+      children = [
+        { value = "Toronto" },
+        { value = "Austin", disabled = true },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira filter by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_filter.example <FILTER_ID>
+# Example:
+#   terraform import jira_filter.example 10000
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <FILTER_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_filter.example "$1"
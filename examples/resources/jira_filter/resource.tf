@@ -0,0 +1,23 @@
+resource "jira_filter" "open_bugs" {
+  name        = "PROJ open bugs"
+  description = "Backs the team dashboard"
+  jql         = "project = PROJ AND type = Bug AND resolution = Unresolved ORDER BY priority DESC"
+  favourite   = true
+
+  # Everyone who can browse PROJ can view the filter.
+  share_permissions = [
+    {
+      type       = "project"
+      project_id = "10000"
+    },
+  ]
+
+  # Members of the project's Administrators role can edit it.
+  edit_permissions = [
+    {
+      type       = "project_role"
+      project_id = "10000"
+      role_id    = "10002"
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira group by its group ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_group.example <GROUP_ID>
+# Example:
+#   terraform import jira_group.example 276f955c-63d7-42c8-9520-92d01dca0625
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <GROUP_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_group.example "$1"
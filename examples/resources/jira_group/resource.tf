@@ -0,0 +1,5 @@
+# Group used in permission grants and project role actors
+
+resource "jira_group" "example" {
+  name = "release-team"
+}
@@ -0,0 +1,15 @@
+# Add users to a group
+
+resource "jira_group" "release_team" {
+  name = "release-team"
+}
+
+# Additive (the default): users added in Jira are left alone
+resource "jira_group_membership" "release_team" {
+  group_id = jira_group.release_team.id
+
+  users = [
+    "5b10a2844c20165700ede21g",
+    "5b10ac8d82e05b22cc7d4ef5",
+  ]
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira issue link type by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_issue_link_type.example <LINK_TYPE_ID>
+# Example:
+#   terraform import jira_issue_link_type.example 10000
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <LINK_TYPE_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_issue_link_type.example "$1"
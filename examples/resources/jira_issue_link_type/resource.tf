@@ -0,0 +1,17 @@
+resource "jira_issue_link_type" "blocks" {
+  name    = "Blocks"
+  inward  = "is blocked by"
+  outward = "blocks"
+}
+
+resource "jira_issue_link_type" "implements" {
+  name    = "Implements"
+  inward  = "is implemented by"
+  outward = "implements"
+}
+
+resource "jira_issue_link_type" "risk" {
+  name    = "Risk"
+  inward  = "is risk for"
+  outward = "has risk"
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira issue security level by its scheme ID and level ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_issue_security_level.example <SCHEME_ID>/<LEVEL_ID>
+# Example:
+#   terraform import jira_issue_security_level.example 10000/10021
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <SCHEME_ID>/<LEVEL_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_issue_security_level.example "$1"
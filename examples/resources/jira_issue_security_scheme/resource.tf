@@ -0,0 +1,34 @@
+# Issue security scheme with an internal default level and a restricted level
+
+resource "jira_issue_security_scheme" "example" {
+  name        = "Regulated projects"
+  description = "Managed by Terraform"
+}
+
+resource "jira_issue_security_level" "internal" {
+  scheme_id  = jira_issue_security_scheme.example.id
+  name       = "Internal"
+  is_default = true
+
+  members = [
+    { type = "reporter" },
+    { type = "assignee" },
+    {
+      type      = "projectRole"
+      parameter = "10002"
+    },
+  ]
+}
+
+resource "jira_issue_security_level" "restricted" {
+  scheme_id   = jira_issue_security_scheme.example.id
+  name        = "Restricted"
+  description = "Compliance team only"
+
+  members = [
+    {
+      type      = "group"
+      parameter = "5b10ac8d82e05b22cc7d4ef5"
+    },
+  ]
+}
@@ -0,0 +1,19 @@
+resource "jira_work_type" "story" {
+  name = "Story"
+}
+
+resource "jira_work_type" "bug" {
+  name = "Bug"
+}
+
+resource "jira_issue_type_scheme" "example" {
+  name        = "Engineering Issue Type Scheme"
+  description = "Work types available to engineering projects"
+
+  # Listed in the order Jira shows them.
+  work_type_ids        = [jira_work_type.story.id, jira_work_type.bug.id]
+  default_work_type_id = jira_work_type.story.id
+
+  # Optional: classic projects that should use this scheme.
+  project_ids = ["10000"]
+}
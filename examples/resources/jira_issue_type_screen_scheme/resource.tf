@@ -0,0 +1,28 @@
+resource "jira_screen" "bugs" {
+  name = "Engineering Bug Screen"
+}
+
+resource "jira_screen_scheme" "bugs" {
+  name              = "Engineering Bug Screen Scheme"
+  default_screen_id = jira_screen.bugs.id
+}
+
+data "jira_work_types" "bugs" {
+  names = ["Bug"]
+}
+
+resource "jira_issue_type_screen_scheme" "example" {
+  name        = "Engineering Issue Type Screen Scheme"
+  description = "Screen schemes used by engineering projects"
+
+  # Used for every work type without its own mapping; "1" is Jira's Default Screen Scheme.
+  default_screen_scheme_id = "1"
+
+  # Map of work type ID to screen scheme ID. jira_work_types is keyed by work type ID, so it composes directly.
+  work_type_screen_schemes = {
+    for id, _ in data.jira_work_types.bugs.work_types : id => jira_screen_scheme.bugs.id
+  }
+
+  # Optional: classic projects that should use this scheme.
+  project_ids = ["10000"]
+}
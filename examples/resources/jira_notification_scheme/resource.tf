@@ -0,0 +1,40 @@
+# Notification scheme routing issue events to assignees, reporters, watchers, a group and a project role
+
+resource "jira_field" "approver" {
+  name       = "Approver"
+  field_type = "userpicker"
+}
+
+resource "jira_notification_scheme" "example" {
+  name        = "Engineering notification scheme"
+  description = "Managed by Terraform"
+
+  events = {
+    # Issue created
+    "1" = {
+      recipients = [
+        { type = "CurrentAssignee" },
+        { type = "Reporter" },
+        { type = "AllWatchers" },
+        {
+          type      = "Group"
+          parameter = "jira-software-users"
+        },
+      ]
+    }
+    # Issue resolved
+    "4" = {
+      recipients = [
+        { type = "Reporter" },
+        {
+          type      = "UserCustomField"
+          parameter = jira_field.approver.id
+        },
+        {
+          type      = "ProjectRole"
+          parameter = "10002"
+        },
+      ]
+    }
+  }
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira permission scheme by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_permission_scheme.example <SCHEME_ID>
+# Example:
+#   terraform import jira_permission_scheme.example 10000
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <SCHEME_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_permission_scheme.example "$1"
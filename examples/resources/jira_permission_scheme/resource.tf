@@ -0,0 +1,35 @@
+# Permission scheme granting project access to a group and administration to a project role
+
+data "jira_permissions" "project" {
+  type = "PROJECT"
+}
+
+resource "jira_permission_scheme" "example" {
+  name        = "Engineering permission scheme"
+  description = "Managed by Terraform"
+
+  grants = [
+    {
+      permission       = "BROWSE_PROJECTS"
+      holder_type      = "group"
+      holder_parameter = "jira-software-users"
+    },
+    {
+      permission  = "CREATE_ISSUES"
+      holder_type = "applicationRole"
+    },
+    {
+      permission       = "ADMINISTER_PROJECTS"
+      holder_type      = "projectRole"
+      holder_parameter = "10002"
+    },
+    {
+      permission  = "EDIT_ISSUES"
+      holder_type = "assignee"
+    },
+  ]
+}
+
+output "project_permission_keys" {
+  value = data.jira_permissions.project.keys
+}
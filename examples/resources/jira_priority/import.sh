@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira priority by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_priority.example <PRIORITY_ID>
+# Example:
+#   terraform import jira_priority.example 10001
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <PRIORITY_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_priority.example "$1"
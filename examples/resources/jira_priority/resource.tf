@@ -0,0 +1,14 @@
+resource "jira_priority" "blocker" {
+  name         = "Blocker"
+  description  = "Blocks development or testing work"
+  status_color = "#D04437"
+  icon_url     = "/images/icons/priorities/blocker.svg"
+}
+
+resource "jira_priority" "normal" {
+  name         = "Normal"
+  status_color = "#4A6785"
+
+  # The site default, given to new work items without a priority.
+  is_default = true
+}
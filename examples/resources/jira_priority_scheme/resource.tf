@@ -0,0 +1,26 @@
+resource "jira_priority" "blocker" {
+  name         = "Blocker"
+  status_color = "#D04437"
+}
+
+resource "jira_priority" "normal" {
+  name         = "Normal"
+  status_color = "#4A6785"
+}
+
+resource "jira_priority" "minor" {
+  name         = "Minor"
+  status_color = "#707070"
+}
+
+resource "jira_priority_scheme" "example" {
+  name        = "Engineering Priority Scheme"
+  description = "Priorities available to engineering projects"
+
+  # Listed from highest to lowest.
+  priority_ids        = [jira_priority.blocker.id, jira_priority.normal.id, jira_priority.minor.id]
+  default_priority_id = jira_priority.normal.id
+
+  # Optional: projects that should use this scheme.
+  project_ids = ["10000"]
+}
@@ -8,4 +8,13 @@ resource "jira_project" "example" {
   # description     = "Engineering project"
   # assignee_type   = "PROJECT_LEAD"
   # category_id     = 10000
+
+  # Optional scheme associations; omitted schemes are left as Jira assigned them.
+  # workflow_scheme_id            = "10100"
+  # permission_scheme_id          = "0"
+  # issue_type_scheme_id          = "10000"
+  # issue_type_screen_scheme_id   = "10000"
+  # field_configuration_scheme_id = "10000"
+  # notification_scheme_id        = "10000"
+  # issue_security_scheme_id      = "10000"
 }
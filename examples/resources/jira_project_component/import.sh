@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira project component by project key and component ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_project_component.example <PROJECT_KEY>/<COMPONENT_ID>
+# Example:
+#   terraform import jira_project_component.example PROJ/10000
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <PROJECT_KEY>/<COMPONENT_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_project_component.example "$1"
@@ -0,0 +1,9 @@
+resource "jira_project_component" "backend" {
+  project_key = "PROJ"
+  name        = "Backend"
+  description = "APIs and background jobs"
+
+  # Work items with this component are assigned to the component lead.
+  lead_account_id = "5b10ac8d82e05b22cc7d4ef5"
+  assignee_type   = "COMPONENT_LEAD"
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira project role by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_project_role.example <ROLE_ID>
+# Example:
+#   terraform import jira_project_role.example 10002
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <ROLE_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_project_role.example "$1"
@@ -0,0 +1,6 @@
+# Global project role, available in every company-managed project
+
+resource "jira_project_role" "example" {
+  name        = "Release Managers"
+  description = "Approves and ships releases"
+}
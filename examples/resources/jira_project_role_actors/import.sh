@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import the actors of a Jira project role by project ID or key and role ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_project_role_actors.example <PROJECT>/<ROLE_ID>
+# Example:
+#   terraform import jira_project_role_actors.example PROJ/10002
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <PROJECT>/<ROLE_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_project_role_actors.example "$1"
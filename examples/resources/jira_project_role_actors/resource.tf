@@ -0,0 +1,23 @@
+# Assign users and a group to a project role within one project
+
+resource "jira_project_role" "release_managers" {
+  name = "Release Managers"
+}
+
+# Additive (the default): members added in Jira are left alone
+resource "jira_project_role_actors" "release_managers" {
+  project = "PROJ"
+  role_id = jira_project_role.release_managers.id
+
+  users  = ["5b10a2844c20165700ede21g"]
+  groups = ["release-team"]
+}
+
+# Authoritative: any member not listed here is removed from the role
+resource "jira_project_role_actors" "release_managers_ops" {
+  project = "OPS"
+  role_id = jira_project_role.release_managers.id
+  mode    = "authoritative"
+
+  groups = ["release-team"]
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira project version by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_project_version.example <VERSION_ID>
+# Example:
+#   terraform import jira_project_version.example 10000
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <VERSION_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_project_version.example "$1"
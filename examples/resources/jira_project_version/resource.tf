@@ -0,0 +1,19 @@
+resource "jira_project_version" "v1_0" {
+  project_id   = "10000"
+  name         = "1.0"
+  description  = "First public release"
+  start_date   = "2025-01-06"
+  release_date = "2025-02-14"
+  released     = true
+
+  # Unresolved work items still fixed in 1.0 move to 1.1 when 1.0 is released.
+  move_unfixed_issues_to = jira_project_version.v1_1.id
+}
+
+resource "jira_project_version" "v1_1" {
+  project_id = "10000"
+  name       = "1.1"
+
+  # RFC 3339 timestamps are accepted; Jira keeps the day only.
+  release_date = "2025-03-31T17:00:00Z"
+}
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira resolution by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_resolution.example <RESOLUTION_ID>
+# Example:
+#   terraform import jira_resolution.example 10001
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <RESOLUTION_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_resolution.example "$1"
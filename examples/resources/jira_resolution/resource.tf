@@ -0,0 +1,12 @@
+resource "jira_resolution" "wont_do" {
+  name        = "Won't Do"
+  description = "The work will not be done"
+}
+
+resource "jira_resolution" "obsolete" {
+  name        = "Obsolete"
+  description = "The work is no longer relevant"
+
+  # Work items resolved as Obsolete become Won't Do when this resolution is destroyed.
+  replacement_id = jira_resolution.wont_do.id
+}
@@ -0,0 +1,4 @@
+resource "jira_screen" "example" {
+  name        = "Engineering Bug Screen"
+  description = "Screen used to create and edit bugs"
+}
@@ -0,0 +1,17 @@
+resource "jira_screen" "default" {
+  name = "Engineering Default Screen"
+}
+
+resource "jira_screen" "create" {
+  name = "Engineering Create Screen"
+}
+
+resource "jira_screen_scheme" "example" {
+  name        = "Engineering Screen Scheme"
+  description = "Screens used by engineering work types"
+
+  default_screen_id = jira_screen.default.id
+
+  # Optional: override the default screen for individual operations.
+  create_screen_id = jira_screen.create.id
+}
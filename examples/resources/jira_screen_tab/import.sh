@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira screen tab by its screen ID and tab ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_screen_tab.example <SCREEN_ID>/<TAB_ID>
+# Example:
+#   terraform import jira_screen_tab.example 10100/10200
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <SCREEN_ID>/<TAB_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_screen_tab.example "$1"
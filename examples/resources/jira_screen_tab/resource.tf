@@ -0,0 +1,27 @@
+resource "jira_screen" "example" {
+  name = "Engineering Bug Screen"
+}
+
+resource "jira_field" "severity" {
+  name       = "Severity"
+  field_type = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+}
+
+resource "jira_screen_tab" "details" {
+  screen_id = jira_screen.example.id
+  name      = "Details"
+  position  = 0
+
+  # Fields are shown in this order; reordering only moves them.
+  field_ids = ["summary", "description", jira_field.severity.id]
+}
+
+resource "jira_screen_tab" "planning" {
+  screen_id = jira_screen.example.id
+  name      = "Planning"
+  position  = 1
+  field_ids = ["duedate", "labels"]
+
+  # Apply tab positions one after another so each move sees the previous one.
+  depends_on = [jira_screen_tab.details]
+}
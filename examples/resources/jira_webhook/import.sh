@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira webhook by its numeric ID.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_webhook.example <WEBHOOK_ID>
+# Example:
+#   terraform import jira_webhook.example 1
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <WEBHOOK_ID>" >&2
+  exit 1
+fi
+
+terraform import jira_webhook.example "$1"
@@ -0,0 +1,13 @@
+variable "triage_webhook_secret" {
+  type      = string
+  sensitive = true
+}
+
+# Send new and updated bugs of the PAY project to the triage service.
+resource "jira_webhook" "triage" {
+  name       = "triage-service"
+  url        = "https://triage.example.com/jira/events"
+  events     = ["jira:issue_created", "jira:issue_updated", "comment_created"]
+  jql_filter = "project = PAY AND issuetype = Bug"
+  secret     = var.triage_webhook_secret
+}
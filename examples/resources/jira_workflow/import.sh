@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# Import a Jira workflow by its name.
+# Usage:
+#   terraform init    # ensure provider is initialized
+#   terraform import jira_workflow.example "<WORKFLOW_NAME>"
+# Example:
+#   terraform import jira_workflow.example "Delivery Workflow"
+
+set -euo pipefail
+
+if [ "${1:-}" = "" ]; then
+  echo "Usage: $0 <WORKFLOW_NAME>" >&2
+  exit 1
+fi
+
+terraform import jira_workflow.example "$1"
@@ -0,0 +1,62 @@
+resource "jira_workflow_status" "todo" {
+  name            = "Backlog"
+  status_category = "TODO"
+}
+
+resource "jira_workflow_status" "in_progress" {
+  name            = "Doing"
+  status_category = "IN_PROGRESS"
+}
+
+resource "jira_workflow_status" "done" {
+  name            = "Shipped"
+  status_category = "DONE"
+}
+
+resource "jira_workflow" "example" {
+  name        = "Delivery Workflow"
+  description = "Managed by Terraform"
+
+  status_ids = [
+    jira_workflow_status.todo.id,
+    jira_workflow_status.in_progress.id,
+    jira_workflow_status.done.id,
+  ]
+
+  transitions = [
+    {
+      id           = "1"
+      name         = "Create"
+      type         = "INITIAL"
+      to_status_id = jira_workflow_status.todo.id
+    },
+    {
+      id              = "11"
+      name            = "Start work"
+      from_status_ids = [jira_workflow_status.todo.id]
+      to_status_id    = jira_workflow_status.in_progress.id
+      conditions = [
+        {
+          rule_key = "system:restrict-issue-transition"
+          parameters = {
+            permissionKeys = "TRANSITION_ISSUES"
+          }
+        },
+      ]
+    },
+    {
+      id           = "21"
+      name         = "Ship"
+      type         = "GLOBAL"
+      to_status_id = jira_workflow_status.done.id
+      validators = [
+        {
+          rule_key = "system:check-permission-validator"
+          parameters = {
+            permissionKey = "RESOLVE_ISSUES"
+          }
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,13 @@
+resource "jira_work_type" "bug" {
+  name = "Bug"
+}
+
+resource "jira_workflow_scheme" "example" {
+  name             = "Delivery Workflow Scheme"
+  description      = "Managed by Terraform"
+  default_workflow = "jira"
+
+  work_type_workflows = {
+    (jira_work_type.bug.id) = "jira"
+  }
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*announcementBannerResource)(nil)
+var _ resource.ResourceWithConfigure = (*announcementBannerResource)(nil)
+var _ resource.ResourceWithImportState = (*announcementBannerResource)(nil)
+
+// NewAnnouncementBannerResource returns the Terraform resource implementation for jira_announcement_banner.
+func NewAnnouncementBannerResource() resource.Resource { return &announcementBannerResource{} }
+
+type announcementBannerResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[announcementBannerResourceModel, *announcementBannerPayload, *models.AnnouncementBannerScheme]
+}
+
+func (r *announcementBannerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_announcement_banner"
+}
+
+func (r *announcementBannerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *announcementBannerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the announcement banner of the Jira site. A site has exactly one banner, so declare at most one of these resources; destroying it disables the banner.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Always `" + announcementBannerID + "`.",
+			},
+			"message": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The text of the banner.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the banner is shown. Defaults to `true`.",
+			},
+			"dismissible": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether users can close the banner. Defaults to `true`.",
+			},
+			"visibility": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(bannerVisibilityPrivate),
+				MarkdownDescription: "Who sees the banner: `private` (logged-in users) or `public` (also anonymous users). Defaults to `private`.",
+				Validators:          []validator.String{stringvalidator.OneOf(bannerVisibilityPrivate, bannerVisibilityPublic)},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the announcement banner endpoint. Updates are sent directly (see
+// announcementBannerPayload); the banner always exists, so create is an update.
+func (r *announcementBannerResource) updateBanner(ctx context.Context, p *announcementBannerPayload) (*models.AnnouncementBannerScheme, *models.ResponseScheme, error) {
+	if rs, err := callJira(ctx, r.client, http.MethodPut, "rest/api/3/announcementBanner", p, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.client.Banner.Get(ctx)
+}
+
+// deleteBanner disables the banner and leaves its message in place.
+func (r *announcementBannerResource) deleteBanner(ctx context.Context, _ string) (*models.ResponseScheme, error) {
+	body := map[string]bool{"isEnabled": false}
+	return callJira(ctx, r.client, http.MethodPut, "rest/api/3/announcementBanner", body, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *announcementBannerResource) hooks() CRUDHooks[announcementBannerResourceModel, *announcementBannerPayload, *models.AnnouncementBannerScheme] {
+	return CRUDHooks[announcementBannerResourceModel, *announcementBannerPayload, *models.AnnouncementBannerScheme]{
+		BuildPayload: func(_ context.Context, st *announcementBannerResourceModel) (*announcementBannerPayload, diag.Diagnostics) {
+			return &announcementBannerPayload{
+				Message:       st.Message.ValueString(),
+				IsEnabled:     st.Enabled.ValueBool(),
+				IsDismissible: st.Dismissible.ValueBool(),
+				Visibility:    st.Visibility.ValueString(),
+			}, nil
+		},
+		APICreate: r.updateBanner,
+		APIRead: func(ctx context.Context, _ string) (*models.AnnouncementBannerScheme, *models.ResponseScheme, error) {
+			return r.client.Banner.Get(ctx)
+		},
+		APIUpdate: func(ctx context.Context, _ string, p *announcementBannerPayload) (*models.AnnouncementBannerScheme, *models.ResponseScheme, error) {
+			return r.updateBanner(ctx, p)
+		},
+		APIDelete:  r.deleteBanner,
+		ExtractID:  func(st *announcementBannerResourceModel) string { return st.ID.ValueString() },
+		MapToState: mapAnnouncementBannerToModel,
+	}
+}
+
+func (r *announcementBannerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *announcementBannerResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *announcementBannerResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *announcementBannerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *announcementBannerResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *announcementBannerResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *announcementBannerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *announcementBannerResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *announcementBannerResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *announcementBannerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *announcementBannerResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *announcementBannerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	if request.ID != announcementBannerID {
+		response.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("The announcement banner is imported with the ID %q.", announcementBannerID))
+		return
+	}
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *announcementBannerResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
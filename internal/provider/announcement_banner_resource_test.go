@@ -0,0 +1,69 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// The banner is a single site-wide setting, so its tests run one at a time.
+func TestAccAnnouncementBannerResource_basic(t *testing.T) {
+	resourceName := "jira_announcement_banner.test"
+	message := acctest.RandomWithPrefix(accPrefixBanner)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetAnnouncementBannerCfg(t, testhelpers.AnnouncementBannerTmplCfg{
+					Message:     message,
+					Enabled:     true,
+					Dismissible: true,
+					Visibility:  bannerVisibilityPrivate,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.StringExact(announcementBannerID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("message"), knownvalue.StringExact(message)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("dismissible"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				// False values are sent explicitly, so the banner can be made permanent and disabled.
+				Config: testhelpers.GetAnnouncementBannerCfg(t, testhelpers.AnnouncementBannerTmplCfg{
+					Message:     message + " updated",
+					Enabled:     false,
+					Dismissible: false,
+					Visibility:  bannerVisibilityPublic,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("message"), knownvalue.StringExact(message+" updated")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("dismissible"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("visibility"), knownvalue.StringExact(bannerVisibilityPublic)),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+			{
+				ImportState:   true,
+				ResourceName:  resourceName,
+				ImportStateId: "banner",
+				ExpectError:   regexp.MustCompile(`Invalid import ID`),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// announcementBannerID is the ID of the announcement banner; a site has exactly one.
+const announcementBannerID = "announcement_banner"
+
+// Visibilities of the announcement banner.
+const (
+	bannerVisibilityPublic  = "public"
+	bannerVisibilityPrivate = "private"
+)
+
+// announcementBannerResourceModel models the Terraform schema/state for jira_announcement_banner.
+type announcementBannerResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Message     types.String `tfsdk:"message"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Dismissible types.Bool   `tfsdk:"dismissible"`
+	Visibility  types.String `tfsdk:"visibility"`
+}
+
+// announcementBannerPayload carries the planned banner for create/update. go-atlassian omits false properties, so it
+// could neither disable the banner nor make it permanent; the provider defines its own request type.
+type announcementBannerPayload struct {
+	Message       string `json:"message"`
+	IsEnabled     bool   `json:"isEnabled"`
+	IsDismissible bool   `json:"isDismissible"`
+	Visibility    string `json:"visibility"`
+}
+
+// mapAnnouncementBannerToModel centralizes mapping for the announcement banner resource and matches CRUDHooks
+// MapToState signature.
+func mapAnnouncementBannerToModel(_ context.Context, api *models.AnnouncementBannerScheme, st *announcementBannerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no announcement banner payload to map into state.")
+		return diags
+	}
+	*st = announcementBannerResourceModel{
+		ID:          types.StringValue(announcementBannerID),
+		Message:     types.StringValue(api.Message),
+		Enabled:     types.BoolValue(api.IsEnabled),
+		Dismissible: types.BoolValue(api.IsDismissible),
+		Visibility:  types.StringValue(api.Visibility),
+	}
+	return diags
+}
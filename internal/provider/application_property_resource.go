@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*applicationPropertyResource)(nil)
+var _ resource.ResourceWithConfigure = (*applicationPropertyResource)(nil)
+var _ resource.ResourceWithImportState = (*applicationPropertyResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*applicationPropertyResource)(nil)
+
+// NewApplicationPropertyResource returns the Terraform resource implementation for jira_application_property.
+func NewApplicationPropertyResource() resource.Resource { return &applicationPropertyResource{} }
+
+type applicationPropertyResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[applicationPropertyResourceModel, *applicationPropertyPayload, *applicationPropertyAPIModel]
+}
+
+func (r *applicationPropertyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_property"
+}
+
+func (r *applicationPropertyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *applicationPropertyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the value of a Jira application property, such as the advanced settings. Properties always exist, so destroying the resource resets the property to its default value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The key of the property.",
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The key of the property, such as `jira.clone.prefix`. Changing it resets the old property and sets the new one.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The value of the property. Jira stores every value as a string, so numbers and booleans are written as `\"10\"` or `\"true\"`.",
+			},
+			"default_value": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The value the property is reset to when the resource is destroyed.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks, once the provider is configured, that the property exists and that the value is one Jira
+// allows for it, so a typo surfaces at plan time instead of at apply.
+func (r *applicationPropertyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg applicationPropertyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider is not configured during `terraform validate`; the property is checked again at plan time.
+	if r.client == nil || cfg.Key.IsNull() || cfg.Key.IsUnknown() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	// The check is best effort: only a missing property is an error; any other failed read is logged and skipped.
+	property, rs, err := r.getProperty(ctx, cfg.Key.ValueString())
+	switch {
+	case HTTPStatusFromScheme(rs) == http.StatusNotFound:
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "Unknown application property", fmt.Sprintf("Jira has no application property %q.", cfg.Key.ValueString()))
+		return
+	case err != nil || property == nil || !IsSuccess(HTTPStatusFromScheme(rs)):
+		tflog.Debug(ctx, "skipping application property check at plan time", map[string]interface{}{"status": HTTPStatusFromScheme(rs)})
+		return
+	}
+	if cfg.Value.IsNull() || cfg.Value.IsUnknown() || len(property.AllowedValues) == 0 {
+		return
+	}
+	if !slices.Contains(property.AllowedValues, cfg.Value.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid application property value",
+			fmt.Sprintf("%s must be one of: %s.", cfg.Key.ValueString(), strings.Join(property.AllowedValues, ", ")),
+		)
+	}
+}
+
+// Wrapper functions to adapt the application property endpoints. Requests are sent directly (see
+// applicationPropertyPayload); properties always exist, so create is an update.
+func (r *applicationPropertyResource) getProperty(ctx context.Context, key string) (*applicationPropertyAPIModel, *models.ResponseScheme, error) {
+	var properties []*applicationPropertyAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, "rest/api/3/application-properties?"+url.Values{"key": {key}}.Encode(), nil, &properties)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, p := range properties {
+		if p != nil && p.Key == key {
+			return p, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("application property %s not found", key)
+}
+
+func (r *applicationPropertyResource) setProperty(ctx context.Context, key string, p *applicationPropertyPayload) (*applicationPropertyAPIModel, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("rest/api/3/application-properties/%s", url.PathEscape(key))
+	if rs, err := callJira(ctx, r.client, http.MethodPut, endpoint, p, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getProperty(ctx, key)
+}
+
+// resetProperty sets the property back to its default value.
+func (r *applicationPropertyResource) resetProperty(ctx context.Context, key string) (*models.ResponseScheme, error) {
+	property, rs, err := r.getProperty(ctx, key)
+	if err != nil {
+		return rs, err
+	}
+	_, rs, err = r.setProperty(ctx, key, &applicationPropertyPayload{ID: key, Value: property.DefaultValue})
+	return rs, err
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *applicationPropertyResource) hooks() CRUDHooks[applicationPropertyResourceModel, *applicationPropertyPayload, *applicationPropertyAPIModel] {
+	return CRUDHooks[applicationPropertyResourceModel, *applicationPropertyPayload, *applicationPropertyAPIModel]{
+		BuildPayload: func(_ context.Context, st *applicationPropertyResourceModel) (*applicationPropertyPayload, diag.Diagnostics) {
+			return &applicationPropertyPayload{ID: st.Key.ValueString(), Value: st.Value.ValueString()}, nil
+		},
+		APICreate: func(ctx context.Context, p *applicationPropertyPayload) (*applicationPropertyAPIModel, *models.ResponseScheme, error) {
+			return r.setProperty(ctx, p.ID, p)
+		},
+		APIRead:                 r.getProperty,
+		APIUpdate:               r.setProperty,
+		APIDelete:               r.resetProperty,
+		ExtractID:               func(st *applicationPropertyResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapApplicationPropertyToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *applicationPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *applicationPropertyResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *applicationPropertyResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *applicationPropertyResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *applicationPropertyResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *applicationPropertyResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *applicationPropertyResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *applicationPropertyResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *applicationPropertyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *applicationPropertyResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
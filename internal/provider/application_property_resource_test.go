@@ -0,0 +1,66 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// Application properties are site-wide settings, so their tests run one at a time.
+func TestAccApplicationPropertyResource_basic(t *testing.T) {
+	resourceName := "jira_application_property.test"
+	key := "jira.clone.prefix"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetApplicationPropertyCfg(t, testhelpers.ApplicationPropertyTmplCfg{Key: key, Value: "COPY -"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.StringExact(key)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("value"), knownvalue.StringExact("COPY -")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("default_value"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testhelpers.GetApplicationPropertyCfg(t, testhelpers.ApplicationPropertyTmplCfg{Key: key, Value: "DUPLICATE -"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("value"), knownvalue.StringExact("DUPLICATE -")),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAccApplicationPropertyResource_planChecks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testhelpers.GetApplicationPropertyCfg(t, testhelpers.ApplicationPropertyTmplCfg{Key: "jira.no.such.property", Value: "x"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Unknown application property`),
+			},
+			{
+				Config:      testhelpers.GetApplicationPropertyCfg(t, testhelpers.ApplicationPropertyTmplCfg{Key: "jira.issue.actions.order", Value: "sideways"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid application property value`),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applicationPropertyResourceModel models the Terraform schema/state for jira_application_property.
+type applicationPropertyResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+	Value        types.String `tfsdk:"value"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+// applicationPropertyPayload carries the planned value. go-atlassian does not cover application properties, so the
+// provider defines its own request and response types.
+type applicationPropertyPayload struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+// applicationPropertyAPIModel is the application property as returned by Jira. AllowedValues is empty for properties
+// that take any value.
+type applicationPropertyAPIModel struct {
+	ID            string   `json:"id"`
+	Key           string   `json:"key"`
+	Value         string   `json:"value"`
+	DefaultValue  string   `json:"defaultValue"`
+	AllowedValues []string `json:"allowedValues"`
+}
+
+// mapApplicationPropertyToModel centralizes mapping for the application property resource and matches CRUDHooks
+// MapToState signature.
+func mapApplicationPropertyToModel(_ context.Context, api *applicationPropertyAPIModel, st *applicationPropertyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no application property payload to map into state.")
+		return diags
+	}
+	*st = applicationPropertyResourceModel{
+		ID:           types.StringValue(api.Key),
+		Key:          types.StringValue(api.Key),
+		Value:        types.StringValue(api.Value),
+		DefaultValue: types.StringValue(api.DefaultValue),
+	}
+	return diags
+}
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*boardResource)(nil)
+var _ resource.ResourceWithConfigure = (*boardResource)(nil)
+var _ resource.ResourceWithImportState = (*boardResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*boardResource)(nil)
+
+// NewBoardResource returns the Terraform resource implementation for jira_board.
+func NewBoardResource() resource.Resource { return &boardResource{} }
+
+type boardResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[boardResourceModel, *boardPayload, *boardAPIModel]
+}
+
+func (r *boardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board"
+}
+
+func (r *boardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *boardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira Software board. The Agile API cannot rename a board or change its type, filter or location, so changing any of them replaces the board.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the board. Automatically generated by Jira when the board is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the board. Changing it replaces the board.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the board: `scrum` or `kanban`. Changing it replaces the board.",
+				Validators:          []validator.String{stringvalidator.OneOf(boardTypeScrum, boardTypeKanban)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"filter_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the saved filter that selects the issues of the board, such as the ID of a `jira_filter`. Changing it replaces the board.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric filter ID")},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the project the board lives in. When omitted, the board lives in the location of the provider's user. Changing it replaces the board.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"estimation_field_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "ID of the field the board estimates issues with, such as the story points field. Only scrum boards " +
+					"have an estimation field; when omitted, Jira's default is kept.",
+				Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"columns": schema.ListNestedAttribute{
+				Computed: true,
+				MarkdownDescription: "The columns of the board, from left to right. The Agile API does not change columns, so they are " +
+					"read only; configure them in the board settings.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the column.",
+						},
+						"status_ids": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "IDs of the statuses mapped to the column.",
+						},
+						"min": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The minimum number of issues in the column, when constrained.",
+						},
+						"max": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The maximum number of issues in the column, when constrained.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that only scrum boards set an estimation field.
+func (r *boardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg boardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cfg.Type.IsUnknown() || cfg.Type.ValueString() == boardTypeScrum {
+		return
+	}
+	if !cfg.EstimationFieldID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("estimation_field_id"),
+			"Estimation not supported",
+			fmt.Sprintf("Only %q boards have an estimation field.", boardTypeScrum),
+		)
+	}
+}
+
+// Wrapper functions to adapt the Agile board endpoints. Requests are sent directly (see boardPayload).
+func (r *boardResource) createBoard(ctx context.Context, p *boardPayload) (*boardAPIModel, *models.ResponseScheme, error) {
+	var created boardAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/agile/1.0/board", p, &created); err != nil {
+		return nil, rs, err
+	}
+	id := strconv.Itoa(created.ID)
+	if rs, err := r.setEstimation(ctx, id, p.EstimationFieldID); err != nil {
+		return nil, rs, err
+	}
+	return r.getBoard(ctx, id)
+}
+
+// getBoard reads the board together with its configuration.
+func (r *boardResource) getBoard(ctx context.Context, id string) (*boardAPIModel, *models.ResponseScheme, error) {
+	var board boardAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/agile/1.0/board/%s", id), nil, &board)
+	if err != nil {
+		return nil, rs, err
+	}
+	var cfg boardConfiguration
+	if rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/agile/1.0/board/%s/configuration", id), nil, &cfg); err != nil {
+		return nil, rs, err
+	}
+	board.Configuration = &cfg
+	return &board, rs, nil
+}
+
+// updateBoard only has the estimation field to change; everything else replaces the board.
+func (r *boardResource) updateBoard(ctx context.Context, id string, p *boardPayload) (*boardAPIModel, *models.ResponseScheme, error) {
+	if rs, err := r.setEstimation(ctx, id, p.EstimationFieldID); err != nil {
+		return nil, rs, err
+	}
+	return r.getBoard(ctx, id)
+}
+
+func (r *boardResource) deleteBoard(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/agile/1.0/board/%s", id), nil, nil)
+}
+
+// setEstimation sets the estimation field of the board; an empty fieldID keeps the current one.
+func (r *boardResource) setEstimation(ctx context.Context, id, fieldID string) (*models.ResponseScheme, error) {
+	if fieldID == "" {
+		return nil, nil
+	}
+	body := map[string]string{"value": fieldID}
+	return callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/agile/1.0/board/%s/estimation", id), body, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *boardResource) hooks() CRUDHooks[boardResourceModel, *boardPayload, *boardAPIModel] {
+	return CRUDHooks[boardResourceModel, *boardPayload, *boardAPIModel]{
+		BuildPayload: func(_ context.Context, st *boardResourceModel) (*boardPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			filterID, _ := strconv.Atoi(st.FilterID.ValueString())
+			location := &boardLocation{Type: "user"}
+			if !st.ProjectID.IsNull() {
+				location = &boardLocation{Type: "project", ProjectKeyOrID: st.ProjectID.ValueString()}
+			}
+			estimation := ""
+			if !st.EstimationFieldID.IsUnknown() {
+				estimation = st.EstimationFieldID.ValueString()
+			}
+			return &boardPayload{
+				Name:              st.Name.ValueString(),
+				Type:              st.Type.ValueString(),
+				FilterID:          filterID,
+				Location:          location,
+				EstimationFieldID: estimation,
+			}, diags
+		},
+		APICreate:               r.createBoard,
+		APIRead:                 r.getBoard,
+		APIUpdate:               r.updateBoard,
+		APIDelete:               r.deleteBoard,
+		ExtractID:               func(st *boardResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapBoardToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *boardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *boardResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *boardResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *boardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *boardResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *boardResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *boardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *boardResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *boardResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *boardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *boardResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *boardResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *boardResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBoardResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_board.test"
+	cfg := testhelpers.BoardTmplCfg{
+		ProjectKey:    randomProjectKey(6),
+		Name:          acctest.RandomWithPrefix(accPrefixBoard),
+		LeadAccountID: testhelpers.GetTestProjLeadAcctIdFromEnv(),
+		Type:          boardTypeScrum,
+	}
+	updated := cfg
+	updated.EstimationFieldID = "timeoriginalestimate"
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetBoardCfg(t, cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("type"), knownvalue.StringExact(boardTypeScrum)),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New("filter_id"), "jira_filter.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New("project_id"), "jira_project.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("estimation_field_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("columns"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.jira_boards.project", tfjsonpath.New("boards"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				// The estimation field is the one setting changed in place.
+				Config: testhelpers.GetBoardCfg(t, updated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("estimation_field_id"), knownvalue.StringExact("timeoriginalestimate")),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    resourceName,
+			},
+		},
+	})
+}
+
+func TestAccBoardResource_kanbanEstimation(t *testing.T) {
+	t.Parallel()
+
+	cfg := testhelpers.BoardTmplCfg{
+		ProjectKey:        randomProjectKey(6),
+		Name:              acctest.RandomWithPrefix(accPrefixBoard),
+		LeadAccountID:     testhelpers.GetTestProjLeadAcctIdFromEnv(),
+		Type:              boardTypeKanban,
+		EstimationFieldID: "timeoriginalestimate",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testhelpers.GetBoardCfg(t, cfg),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Estimation not supported`),
+			},
+		},
+	})
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Board types of the Agile API. Team-managed projects come with simple boards, which can be listed but not created.
+const (
+	boardTypeScrum  = "scrum"
+	boardTypeKanban = "kanban"
+	boardTypeSimple = "simple"
+)
+
+// boardResourceModel models the Terraform schema/state for jira_board.
+type boardResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	FilterID          types.String `tfsdk:"filter_id"`
+	ProjectID         types.String `tfsdk:"project_id"`
+	EstimationFieldID types.String `tfsdk:"estimation_field_id"`
+	Columns           types.List   `tfsdk:"columns"`
+}
+
+// boardColumnModel models a single element of the columns list.
+type boardColumnModel struct {
+	Name      types.String `tfsdk:"name"`
+	StatusIDs types.List   `tfsdk:"status_ids"`
+	Min       types.Int64  `tfsdk:"min"`
+	Max       types.Int64  `tfsdk:"max"`
+}
+
+func (m *boardColumnModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":       types.StringType,
+		"status_ids": types.ListType{ElemType: types.StringType},
+		"min":        types.Int64Type,
+		"max":        types.Int64Type,
+	}
+}
+
+// boardModel is the element type of the boards map of the jira_boards data source.
+type boardModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+func (m *boardModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"name":       types.StringType,
+		"type":       types.StringType,
+		"project_id": types.StringType,
+	}
+}
+
+// boardPayload carries the planned board for create. The provider's client only covers the platform API, so the
+// Agile API is called directly. EstimationFieldID is set through its own endpoint.
+type boardPayload struct {
+	Name              string         `json:"name"`
+	Type              string         `json:"type"`
+	FilterID          int            `json:"filterId"`
+	Location          *boardLocation `json:"location,omitempty"`
+	EstimationFieldID string         `json:"-"`
+}
+
+// boardLocation is where a board lives: a project, or the user that created it.
+type boardLocation struct {
+	Type           string `json:"type,omitempty"`
+	ProjectKeyOrID string `json:"projectKeyOrId,omitempty"`
+	ProjectID      int    `json:"projectId,omitempty"`
+}
+
+// boardAPIModel is the board as returned by Jira. Filter, column and estimation settings come from the board
+// configuration and are only filled in for a single board.
+type boardAPIModel struct {
+	ID            int                 `json:"id"`
+	Name          string              `json:"name"`
+	Type          string              `json:"type"`
+	Location      *boardLocation      `json:"location,omitempty"`
+	Configuration *boardConfiguration `json:"-"`
+}
+
+// boardConfiguration is the configuration of a board as returned by Jira.
+type boardConfiguration struct {
+	Filter struct {
+		ID string `json:"id"`
+	} `json:"filter"`
+	ColumnConfig struct {
+		Columns []*boardColumn `json:"columns"`
+	} `json:"columnConfig"`
+	Estimation *struct {
+		Type  string `json:"type"`
+		Field *struct {
+			FieldID string `json:"fieldId"`
+		} `json:"field,omitempty"`
+	} `json:"estimation,omitempty"`
+}
+
+// boardColumn is a column of a board. Min and Max are the column constraints; nil when unset.
+type boardColumn struct {
+	Name     string `json:"name"`
+	Statuses []struct {
+		ID string `json:"id"`
+	} `json:"statuses"`
+	Min *int64 `json:"min,omitempty"`
+	Max *int64 `json:"max,omitempty"`
+}
+
+// boardPage is a page of boards as returned by the Agile API.
+type boardPage struct {
+	IsLast bool             `json:"isLast"`
+	Values []*boardAPIModel `json:"values"`
+}
+
+// boardProjectID returns the ID of the project a board lives in, or null for boards in a user's location.
+func boardProjectID(api *boardAPIModel) types.String {
+	if api.Location == nil || api.Location.ProjectID == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.Itoa(api.Location.ProjectID))
+}
+
+// mapBoardToModel centralizes mapping for the board resource and matches CRUDHooks MapToState signature.
+func mapBoardToModel(ctx context.Context, api *boardAPIModel, st *boardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.Configuration == nil {
+		diags.AddError("Empty API model", "The Jira API returned no board payload to map into state.")
+		return diags
+	}
+	cfg := api.Configuration
+
+	columns := make([]boardColumnModel, 0, len(cfg.ColumnConfig.Columns))
+	for _, c := range cfg.ColumnConfig.Columns {
+		if c == nil {
+			continue
+		}
+		statusIDs := make([]string, 0, len(c.Statuses))
+		for _, s := range c.Statuses {
+			statusIDs = append(statusIDs, s.ID)
+		}
+		ids, d := types.ListValueFrom(ctx, types.StringType, statusIDs)
+		diags.Append(d...)
+		columns = append(columns, boardColumnModel{
+			Name:      types.StringValue(c.Name),
+			StatusIDs: ids,
+			Min:       types.Int64PointerValue(c.Min),
+			Max:       types.Int64PointerValue(c.Max),
+		})
+	}
+	columnList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&boardColumnModel{}).AttributeTypes()}, columns)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	estimation := types.StringNull()
+	if cfg.Estimation != nil && cfg.Estimation.Type == "field" && cfg.Estimation.Field != nil {
+		estimation = types.StringValue(cfg.Estimation.Field.FieldID)
+	}
+
+	*st = boardResourceModel{
+		ID:                types.StringValue(strconv.Itoa(api.ID)),
+		Name:              types.StringValue(api.Name),
+		Type:              types.StringValue(api.Type),
+		FilterID:          types.StringValue(cfg.Filter.ID),
+		ProjectID:         boardProjectID(api),
+		EstimationFieldID: estimation,
+		Columns:           columnList,
+	}
+	return diags
+}
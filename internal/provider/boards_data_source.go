@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*boardsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*boardsDataSource)(nil)
+
+// boardsPageSize is the page size used when paging through the Agile board endpoint.
+const boardsPageSize = 50
+
+// NewBoardsDataSource returns the Terraform data source implementation for jira_boards.
+func NewBoardsDataSource() datasource.DataSource { return &boardsDataSource{} }
+
+type boardsDataSource struct {
+	ServiceClient
+}
+
+type boardsDataSourceModel struct {
+	// Optional filters
+	ProjectKeyOrID types.String `tfsdk:"project_key_or_id"`
+	Type           types.String `tfsdk:"type"`
+
+	// Outputs
+	Boards types.Map `tfsdk:"boards"`
+}
+
+func (d *boardsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_boards"
+}
+
+func (d *boardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List Jira Software boards, optionally filtered by project or board type. Without filters every board the provider's user can see is returned.",
+		Attributes: map[string]schema.Attribute{
+			"project_key_or_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by project key or ID. Jira returns the boards whose filter refers to the project, which are not necessarily located in it.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by board type: `scrum`, `kanban` or `simple` (the boards of team-managed projects).",
+				Validators:          []validator.String{stringvalidator.OneOf(boardTypeScrum, boardTypeKanban, boardTypeSimple)},
+			},
+			"boards": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of boards keyed by board ID. Each value includes id, name, type and project_id.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The board ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The board name.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The board type.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the project the board lives in; null for boards in a user's location.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *boardsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *boardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data boardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ProjectKeyOrID.IsUnknown() || data.Type.IsUnknown() {
+		return
+	}
+
+	query := url.Values{"maxResults": {strconv.Itoa(boardsPageSize)}}
+	if !data.ProjectKeyOrID.IsNull() {
+		query.Set("projectKeyOrId", data.ProjectKeyOrID.ValueString())
+	}
+	if !data.Type.IsNull() {
+		query.Set("type", data.Type.ValueString())
+	}
+	out := make(map[string]boardModel)
+	for startAt := 0; ; {
+		query.Set("startAt", strconv.Itoa(startAt))
+		var page boardPage
+		apiResp, err := callJira(ctx, d.client, http.MethodGet, "rest/agile/1.0/board?"+query.Encode(), nil, &page)
+		if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "list boards", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+		for _, b := range page.Values {
+			if b == nil {
+				continue
+			}
+			id := strconv.Itoa(b.ID)
+			out[id] = boardModel{
+				ID:        types.StringValue(id),
+				Name:      types.StringValue(b.Name),
+				Type:      types.StringValue(b.Type),
+				ProjectID: boardProjectID(b),
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	boards, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: (&boardModel{}).AttributeTypes()}, out)
+	if diags.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("boards"),
+			"Failed to build boards map",
+			fmt.Sprintf("Could not encode %d boards into state. See diagnostics for details.", len(out)),
+		)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	data.Boards = boards
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
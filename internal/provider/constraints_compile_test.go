@@ -15,7 +15,40 @@ import (
 var (
 	_ CRUDRunner[workTypeResourceModel, *models.IssueTypePayloadScheme, *models.IssueTypeScheme]
 	_ CRUDRunner[projectResourceModel, *models.ProjectPayloadScheme, *models.ProjectScheme]
+	_ CRUDRunner[projectWithSchemesResourceModel, *models.ProjectPayloadScheme, *projectAPIModel]
 	_ CRUDRunner[projectCategoryResourceModel, *models.ProjectCategoryPayloadScheme, *models.ProjectCategoryScheme]
+	_ CRUDRunner[workflowStatusResourceModel, *models.WorkflowStatusPayloadScheme, *models.WorkflowStatusDetailScheme]
+	_ CRUDRunner[workflowResourceModel, *models.WorkflowCreatesPayload, *models.JiraWorkflowScheme]
+	_ CRUDRunner[workflowSchemeResourceModel, *models.WorkflowSchemePayloadScheme, *workflowSchemeAPIModel]
+	_ CRUDRunner[issueTypeSchemeResourceModel, *models.IssueTypeSchemePayloadScheme, *issueTypeSchemeAPIModel]
+	_ CRUDRunner[screenResourceModel, *models.ScreenScheme, *models.ScreenScheme]
+	_ CRUDRunner[screenTabResourceModel, *screenTabPayload, *screenTabAPIModel]
+	_ CRUDRunner[screenSchemeResourceModel, *models.ScreenSchemePayloadScheme, *models.ScreenSchemeScheme]
+	_ CRUDRunner[issueTypeScreenSchemeResourceModel, *models.IssueTypeScreenSchemePayloadScheme, *issueTypeScreenSchemeAPIModel]
+	_ CRUDRunner[fieldContextResourceModel, *fieldContextPayload, *fieldContextAPIModel]
+	_ CRUDRunner[fieldContextOptionsResourceModel, *fieldContextOptionsPayload, *fieldContextOptionsAPIModel]
+	_ CRUDRunner[fieldConfigurationResourceModel, *fieldConfigurationPayload, *fieldConfigurationAPIModel]
+	_ CRUDRunner[fieldConfigurationSchemeResourceModel, *fieldConfigurationSchemePayload, *fieldConfigurationSchemeAPIModel]
+	_ CRUDRunner[permissionSchemeResourceModel, *models.PermissionSchemeScheme, *models.PermissionSchemeScheme]
+	_ CRUDRunner[notificationSchemeResourceModel, *models.NotificationSchemePayloadScheme, *models.NotificationSchemeScheme]
+	_ CRUDRunner[issueSecuritySchemeResourceModel, *issueSecuritySchemePayload, *issueSecuritySchemeAPIModel]
+	_ CRUDRunner[issueSecurityLevelResourceModel, *issueSecurityLevelPayload, *issueSecurityLevelAPIModel]
+	_ CRUDRunner[projectRoleResourceModel, *models.ProjectRolePayloadScheme, *models.ProjectRoleScheme]
+	_ CRUDRunner[projectRoleActorsResourceModel, *projectRoleActorsPayload, *projectRoleActorsAPIModel]
+	_ CRUDRunner[groupResourceModel, *models.GroupDetailScheme, *models.GroupDetailScheme]
+	_ CRUDRunner[groupMembershipResourceModel, *groupMembershipPayload, *groupMembershipAPIModel]
+	_ CRUDRunner[priorityResourceModel, *priorityPayload, *priorityAPIModel]
+	_ CRUDRunner[prioritySchemeResourceModel, *prioritySchemePayload, *prioritySchemeAPIModel]
+	_ CRUDRunner[resolutionResourceModel, *resolutionPayload, *resolutionAPIModel]
+	_ CRUDRunner[issueLinkTypeResourceModel, *models.LinkTypeScheme, *models.LinkTypeScheme]
+	_ CRUDRunner[projectComponentResourceModel, *projectComponentPayload, *models.ComponentScheme]
+	_ CRUDRunner[projectVersionResourceModel, *projectVersionPayload, *projectVersionAPIModel]
+	_ CRUDRunner[filterResourceModel, *filterPayload, *filterAPIModel]
+	_ CRUDRunner[dashboardResourceModel, *dashboardPayload, *dashboardAPIModel]
+	_ CRUDRunner[boardResourceModel, *boardPayload, *boardAPIModel]
+	_ CRUDRunner[webhookResourceModel, *webhookPayload, *webhookAPIModel]
+	_ CRUDRunner[announcementBannerResourceModel, *announcementBannerPayload, *models.AnnouncementBannerScheme]
+	_ CRUDRunner[applicationPropertyResourceModel, *applicationPropertyPayload, *applicationPropertyAPIModel]
 )
 
 // ListHooks instantiations (api list item, out model)
@@ -23,4 +56,7 @@ var (
 	_ ListHooks[*models.IssueTypeScheme, workTypeResourceModel]
 	_ ListHooks[*models.ProjectScheme, projectResourceModel]
 	_ ListHooks[*models.ProjectCategoryScheme, projectCategoryResourceModel]
+	_ ListHooks[*models.WorkflowStatusDetailScheme, workflowStatusResourceModel]
+	_ ListHooks[*models.UserScheme, userModel]
+	_ ListHooks[*models.LinkTypeScheme, issueLinkTypeResourceModel]
 )
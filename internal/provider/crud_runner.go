@@ -56,8 +56,10 @@ type PostAPIHook[TState StateConstraint, TAPI APIConstraint] func(ctx context.Co
 //
 // What these do
 // - StateConstraint limits TState to Terraform state models defined by this provider.
-// - PayloadConstraint limits TPayload to concrete go‑atlassian payload types the provider sends.
-// - APIConstraint limits TAPI to the concrete go‑atlassian models returned by the client.
+// - PayloadConstraint limits TPayload to concrete go‑atlassian payload types the provider sends, or to
+//   provider-side payloads when go‑atlassian takes positional arguments instead of a payload type.
+// - APIConstraint limits TAPI to the concrete go‑atlassian models returned by the client, or to thin
+//   provider-side wrappers when a go‑atlassian model omits fields Jira returns.
 //
 // Why constrain?
 // - Compile‑time safety across resources (you can’t wire a project payload into a work type resource).
@@ -73,7 +75,40 @@ type StateConstraint interface {
 	projectResourceModel |
 		workTypeResourceModel |
 		projectCategoryResourceModel |
-		fieldResourceModel
+		fieldResourceModel |
+		workflowStatusResourceModel |
+		workflowResourceModel |
+		workflowSchemeResourceModel |
+		projectWithSchemesResourceModel |
+		issueTypeSchemeResourceModel |
+		screenResourceModel |
+		screenTabResourceModel |
+		screenSchemeResourceModel |
+		issueTypeScreenSchemeResourceModel |
+		fieldContextResourceModel |
+		fieldContextOptionsResourceModel |
+		fieldConfigurationResourceModel |
+		fieldConfigurationSchemeResourceModel |
+		permissionSchemeResourceModel |
+		notificationSchemeResourceModel |
+		issueSecuritySchemeResourceModel |
+		issueSecurityLevelResourceModel |
+		projectRoleResourceModel |
+		projectRoleActorsResourceModel |
+		groupResourceModel |
+		groupMembershipResourceModel |
+		priorityResourceModel |
+		prioritySchemeResourceModel |
+		resolutionResourceModel |
+		issueLinkTypeResourceModel |
+		projectComponentResourceModel |
+		projectVersionResourceModel |
+		filterResourceModel |
+		dashboardResourceModel |
+		boardResourceModel |
+		webhookResourceModel |
+		announcementBannerResourceModel |
+		applicationPropertyResourceModel
 }
 
 // PayloadConstraint enumerates the supported go‑atlassian payload types used in Create/Update.
@@ -81,7 +116,39 @@ type PayloadConstraint interface {
 	*models.ProjectPayloadScheme |
 		*models.IssueTypePayloadScheme |
 		*models.ProjectCategoryPayloadScheme |
-		*models.CustomFieldScheme
+		*models.CustomFieldScheme |
+		*models.WorkflowStatusPayloadScheme |
+		*models.WorkflowCreatesPayload |
+		*models.WorkflowSchemePayloadScheme |
+		*models.IssueTypeSchemePayloadScheme |
+		*models.ScreenScheme |
+		*screenTabPayload |
+		*models.ScreenSchemePayloadScheme |
+		*models.IssueTypeScreenSchemePayloadScheme |
+		*fieldContextPayload |
+		*fieldContextOptionsPayload |
+		*fieldConfigurationPayload |
+		*fieldConfigurationSchemePayload |
+		*models.PermissionSchemeScheme |
+		*models.NotificationSchemePayloadScheme |
+		*issueSecuritySchemePayload |
+		*issueSecurityLevelPayload |
+		*models.ProjectRolePayloadScheme |
+		*projectRoleActorsPayload |
+		*models.GroupDetailScheme |
+		*groupMembershipPayload |
+		*priorityPayload |
+		*prioritySchemePayload |
+		*resolutionPayload |
+		*models.LinkTypeScheme |
+		*projectComponentPayload |
+		*projectVersionPayload |
+		*filterPayload |
+		*dashboardPayload |
+		*boardPayload |
+		*webhookPayload |
+		*announcementBannerPayload |
+		*applicationPropertyPayload
 }
 
 // APIConstraint enumerates the supported go‑atlassian API models returned by service calls.
@@ -89,7 +156,40 @@ type APIConstraint interface {
 	*models.ProjectScheme |
 		*models.IssueTypeScheme |
 		*models.ProjectCategoryScheme |
-		*models.IssueFieldScheme
+		*models.IssueFieldScheme |
+		*models.WorkflowStatusDetailScheme |
+		*models.JiraWorkflowScheme |
+		*workflowSchemeAPIModel |
+		*projectAPIModel |
+		*issueTypeSchemeAPIModel |
+		*models.ScreenScheme |
+		*screenTabAPIModel |
+		*models.ScreenSchemeScheme |
+		*issueTypeScreenSchemeAPIModel |
+		*fieldContextAPIModel |
+		*fieldContextOptionsAPIModel |
+		*fieldConfigurationAPIModel |
+		*fieldConfigurationSchemeAPIModel |
+		*models.PermissionSchemeScheme |
+		*models.NotificationSchemeScheme |
+		*issueSecuritySchemeAPIModel |
+		*issueSecurityLevelAPIModel |
+		*models.ProjectRoleScheme |
+		*projectRoleActorsAPIModel |
+		*models.GroupDetailScheme |
+		*groupMembershipAPIModel |
+		*priorityAPIModel |
+		*prioritySchemeAPIModel |
+		*resolutionAPIModel |
+		*models.LinkTypeScheme |
+		*models.ComponentScheme |
+		*projectVersionAPIModel |
+		*filterAPIModel |
+		*dashboardAPIModel |
+		*boardAPIModel |
+		*webhookAPIModel |
+		*models.AnnouncementBannerScheme |
+		*applicationPropertyAPIModel
 }
 
 // CRUDHooks defines per‑resource behavior consumed by the generic runner.
@@ -182,12 +282,12 @@ type ListPageFunc[TAPIList APIListConstraint] func(ctx context.Context, startAt,
 
 // APIListConstraint enumerates API models that appear in lists.
 type APIListConstraint interface {
-	*models.ProjectScheme | *models.ProjectCategoryScheme | *models.IssueTypeScheme
+	*models.ProjectScheme | *models.ProjectCategoryScheme | *models.IssueTypeScheme | *models.WorkflowStatusDetailScheme | *models.UserScheme | *models.LinkTypeScheme | *models.GroupDetailScheme
 }
 
 // OutModelConstraint enumerates Terraform object models used as list outputs.
 type OutModelConstraint interface {
-	projectResourceModel | projectCategoryResourceModel | workTypeResourceModel
+	projectResourceModel | projectCategoryResourceModel | workTypeResourceModel | workflowStatusResourceModel | userModel | issueLinkTypeResourceModel | groupResourceModel
 }
 
 // ListHooks defines list-to-map helpers for data sources and utilities.
@@ -743,3 +843,32 @@ func (r CRUDRunner[TState, TPayload, TAPI]) DoListProjectCategoriesWithLimit(
 ) (map[string]projectCategoryResourceModel, diag.Diagnostics) {
 	return doListToMapCore(ctx, h, opts)
 }
+
+func (r CRUDRunner[TState, TPayload, TAPI]) DoListWorkflowStatuses(
+	ctx context.Context,
+	h ListHooks[*models.WorkflowStatusDetailScheme, workflowStatusResourceModel],
+) (map[string]workflowStatusResourceModel, diag.Diagnostics) {
+	return doListToMapCore(ctx, h, ListOptions{})
+}
+
+func (r CRUDRunner[TState, TPayload, TAPI]) DoListWorkflowStatusesWithLimit(
+	ctx context.Context,
+	h ListHooks[*models.WorkflowStatusDetailScheme, workflowStatusResourceModel],
+	opts ListOptions,
+) (map[string]workflowStatusResourceModel, diag.Diagnostics) {
+	return doListToMapCore(ctx, h, opts)
+}
+
+func (r CRUDRunner[TState, TPayload, TAPI]) DoListIssueLinkTypes(
+	ctx context.Context,
+	h ListHooks[*models.LinkTypeScheme, issueLinkTypeResourceModel],
+) (map[string]issueLinkTypeResourceModel, diag.Diagnostics) {
+	return doListToMapCore(ctx, h, ListOptions{})
+}
+
+func (r CRUDRunner[TState, TPayload, TAPI]) DoListGroups(
+	ctx context.Context,
+	h ListHooks[*models.GroupDetailScheme, groupResourceModel],
+) (map[string]groupResourceModel, diag.Diagnostics) {
+	return doListToMapCore(ctx, h, ListOptions{})
+}
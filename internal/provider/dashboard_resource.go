@@ -0,0 +1,532 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*dashboardResource)(nil)
+var _ resource.ResourceWithConfigure = (*dashboardResource)(nil)
+var _ resource.ResourceWithImportState = (*dashboardResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*dashboardResource)(nil)
+
+// NewDashboardResource returns the Terraform resource implementation for jira_dashboard.
+func NewDashboardResource() resource.Resource { return &dashboardResource{} }
+
+type dashboardResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[dashboardResourceModel, *dashboardPayload, *dashboardAPIModel]
+}
+
+func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *dashboardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira dashboard and, optionally, its gadgets. The dashboard is owned by the provider's user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the dashboard. Automatically generated by Jira when the dashboard is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the dashboard.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the dashboard.",
+			},
+			"layout": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The column layout of the dashboard: `A` (one column), `AA` (two equal columns), `AB` (narrow left, " +
+					"wide right), `BA` (wide left, narrow right) or `AAA` (three columns). Jira's public REST API does not cover " +
+					"the layout, so it is set and read back through the endpoint the dashboard page uses. When omitted, the " +
+					"layout is left alone and the current one is recorded.",
+				Validators: []validator.String{stringvalidator.OneOf(slices.Sorted(maps.Keys(dashboardLayoutColumns))...)},
+			},
+			"share_permissions": sharePermissionsAttribute("Who can view the dashboard. When omitted, the dashboard is private to its owner."),
+			"edit_permissions":  sharePermissionsAttribute("Who can edit the dashboard, in addition to its owner."),
+			"gadgets": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The gadgets of the dashboard. When set, the list holds every gadget of the dashboard and gadgets " +
+					"added in Jira are removed; when omitted, the gadgets are not managed. A gadget keeps its ID as long as its " +
+					"cell and its module key or URI stay the same; otherwise it is replaced.",
+				Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the gadget.",
+						},
+						"module_key": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The module key of the gadget, such as `com.atlassian.jira.gadgets:filter-results-gadget`. Exactly one of `module_key` and `uri` must be set.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"uri": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The URI of the gadget, for gadgets without a module key. Exactly one of `module_key` and `uri` must be set.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"title": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "The title of the gadget. When omitted, the gadget's own title is used.",
+						},
+						"color": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("blue"),
+							MarkdownDescription: "The colour of the gadget frame: `blue`, `red`, `yellow`, `green`, `cyan`, `purple`, `gray` or `white`. Defaults to `blue`.",
+							Validators:          []validator.String{stringvalidator.OneOf(dashboardGadgetColors...)},
+						},
+						"column": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The zero-based column of the gadget. Must be lower than the number of columns of the layout.",
+							Validators:          []validator.Int64{int64validator.Between(0, 2)},
+						},
+						"row": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The zero-based row of the gadget within its column. Each cell holds at most one gadget.",
+							Validators:          []validator.Int64{int64validator.AtLeast(0)},
+						},
+						"properties": schema.StringAttribute{
+							Optional: true,
+							MarkdownDescription: "The dashboard item properties of the gadget, which hold its configuration, as a JSON object " +
+								"keyed by property key; use `jsonencode`. When set, properties missing from the object are removed; when " +
+								"omitted, the properties are not managed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the permissions and that every gadget names exactly one of module_key and uri, sits in a
+// column of the layout, has a cell of its own and has a JSON object as properties.
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg dashboardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateSharePermissions(ctx, cfg.SharePermissions, path.Root("share_permissions"), &resp.Diagnostics)
+	validateSharePermissions(ctx, cfg.EditPermissions, path.Root("edit_permissions"), &resp.Diagnostics)
+
+	if cfg.Gadgets.IsNull() || cfg.Gadgets.IsUnknown() {
+		return
+	}
+	var gadgets []dashboardGadgetModel
+	resp.Diagnostics.Append(cfg.Gadgets.ElementsAs(ctx, &gadgets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	columns := 0
+	if !cfg.Layout.IsNull() && !cfg.Layout.IsUnknown() {
+		columns = dashboardLayoutColumns[cfg.Layout.ValueString()]
+	}
+	taken := map[dashboardGadgetPosition]int{}
+	for i, g := range gadgets {
+		gadgetPath := path.Root("gadgets").AtListIndex(i)
+		if !g.ModuleKey.IsUnknown() && !g.URI.IsUnknown() && g.ModuleKey.IsNull() == g.URI.IsNull() {
+			resp.Diagnostics.AddAttributeError(gadgetPath, "Invalid gadget", "Exactly one of module_key and uri must be set.")
+		}
+		if columns > 0 && !g.Column.IsNull() && !g.Column.IsUnknown() && g.Column.ValueInt64() >= int64(columns) {
+			resp.Diagnostics.AddAttributeError(
+				gadgetPath.AtName("column"),
+				"Column outside layout",
+				fmt.Sprintf("Layout %q has %d column(s); columns are numbered from 0.", cfg.Layout.ValueString(), columns),
+			)
+		}
+		if !g.Column.IsNull() && !g.Column.IsUnknown() && !g.Row.IsNull() && !g.Row.IsUnknown() {
+			position := dashboardGadgetPosition{Column: int(g.Column.ValueInt64()), Row: int(g.Row.ValueInt64())}
+			if first, ok := taken[position]; ok {
+				resp.Diagnostics.AddAttributeError(
+					gadgetPath,
+					"Duplicate gadget position",
+					fmt.Sprintf("Gadgets %d and %d are both in column %d, row %d.", first, i, position.Column, position.Row),
+				)
+			} else {
+				taken[position] = i
+			}
+		}
+		if !g.Properties.IsNull() && !g.Properties.IsUnknown() {
+			var properties map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(g.Properties.ValueString()), &properties); err != nil || properties == nil {
+				resp.Diagnostics.AddAttributeError(gadgetPath.AtName("properties"), "Invalid gadget properties", "properties must be a JSON object.")
+			}
+		}
+	}
+}
+
+// Wrapper functions to adapt the dashboard endpoints. Requests are sent directly (see dashboardPayload).
+func (r *dashboardResource) createDashboard(ctx context.Context, p *dashboardPayload) (*dashboardAPIModel, *models.ResponseScheme, error) {
+	var created dashboardAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/dashboard", p, &created); err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.applyLayoutAndGadgets(ctx, created.ID, p); err != nil {
+		return nil, rs, err
+	}
+	return r.getDashboard(ctx, created.ID)
+}
+
+// getDashboard reads the dashboard together with its layout, its gadgets and their item properties.
+func (r *dashboardResource) getDashboard(ctx context.Context, id string) (*dashboardAPIModel, *models.ResponseScheme, error) {
+	var dashboard dashboardAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/dashboard/%s", id), nil, &dashboard)
+	if err != nil {
+		return nil, rs, err
+	}
+	dashboard.Layout = r.getLayout(ctx, id)
+	gadgets, rs, err := r.listGadgets(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, g := range gadgets {
+		var keys struct {
+			Keys []struct {
+				Key string `json:"key"`
+			} `json:"keys"`
+		}
+		if rs, err := callJira(ctx, r.client, http.MethodGet, r.gadgetPropertiesEndpoint(id, g.ID), nil, &keys); err != nil {
+			return nil, rs, err
+		}
+		g.Properties = make(map[string]json.RawMessage, len(keys.Keys))
+		for _, k := range keys.Keys {
+			var property struct {
+				Value json.RawMessage `json:"value"`
+			}
+			endpoint := r.gadgetPropertiesEndpoint(id, g.ID) + "/" + url.PathEscape(k.Key)
+			if rs, err := callJira(ctx, r.client, http.MethodGet, endpoint, nil, &property); err != nil {
+				return nil, rs, err
+			}
+			g.Properties[k.Key] = property.Value
+		}
+	}
+	dashboard.Gadgets = gadgets
+	return &dashboard, rs, nil
+}
+
+func (r *dashboardResource) updateDashboard(ctx context.Context, id string, p *dashboardPayload) (*dashboardAPIModel, *models.ResponseScheme, error) {
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/dashboard/%s", id), p, nil); err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.applyLayoutAndGadgets(ctx, id, p); err != nil {
+		return nil, rs, err
+	}
+	return r.getDashboard(ctx, id)
+}
+
+func (r *dashboardResource) deleteDashboard(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/dashboard/%s", id), nil, nil)
+}
+
+// applyLayoutAndGadgets sets the layout before the gadgets, so gadgets in a new column have a place to go. The layout
+// endpoint is not part of the public REST API, so a failed write is logged and the layout Jira keeps is read back.
+func (r *dashboardResource) applyLayoutAndGadgets(ctx context.Context, id string, p *dashboardPayload) (*models.ResponseScheme, error) {
+	if p.Layout != "" {
+		body := map[string]string{"layout": p.Layout}
+		if rs, err := callJira(ctx, r.client, http.MethodPut, r.layoutEndpoint(id), body, nil); err != nil {
+			tflog.Debug(ctx, "dashboard layout not writable", map[string]interface{}{"status": HTTPStatusFromScheme(rs)})
+		}
+	}
+	if p.Gadgets == nil {
+		return nil, nil
+	}
+	return r.syncGadgets(ctx, id, p.Gadgets)
+}
+
+// getLayout reads the layout of the dashboard, or returns "" when Jira does not report a known one. The endpoint is
+// not part of the public REST API, so a failed read is logged and leaves the layout in state unchanged.
+func (r *dashboardResource) getLayout(ctx context.Context, id string) string {
+	var layout struct {
+		Layout string `json:"layout"`
+	}
+	if rs, err := callJira(ctx, r.client, http.MethodGet, r.layoutEndpoint(id), nil, &layout); err != nil {
+		tflog.Debug(ctx, "dashboard layout not readable", map[string]interface{}{"status": HTTPStatusFromScheme(rs)})
+		return ""
+	}
+	if _, ok := dashboardLayoutColumns[layout.Layout]; !ok {
+		return ""
+	}
+	return layout.Layout
+}
+
+func (r *dashboardResource) listGadgets(ctx context.Context, id string) ([]*dashboardGadgetAPIModel, *models.ResponseScheme, error) {
+	var page dashboardGadgetPage
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/dashboard/%s/gadget", id), nil, &page)
+	if err != nil {
+		return nil, rs, err
+	}
+	return page.Gadgets, rs, nil
+}
+
+// syncGadgets makes the gadgets of the dashboard match want. A gadget already in the planned cell with the same module
+// key or URI is updated in place; other gadgets are removed first, so their cells are free for the ones created.
+func (r *dashboardResource) syncGadgets(ctx context.Context, id string, want []*dashboardGadgetAPIModel) (*models.ResponseScheme, error) {
+	have, rs, err := r.listGadgets(ctx, id)
+	if err != nil {
+		return rs, err
+	}
+	matched := make([]int, len(want))
+	kept := map[int]bool{}
+	for i, w := range want {
+		if j := slices.IndexFunc(have, func(h *dashboardGadgetAPIModel) bool { return !kept[h.ID] && sameGadget(h, w) }); j >= 0 {
+			matched[i] = have[j].ID
+			kept[have[j].ID] = true
+		}
+	}
+	for _, h := range have {
+		if kept[h.ID] {
+			continue
+		}
+		if rs, err := callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/dashboard/%s/gadget/%d", id, h.ID), nil, nil); err != nil {
+			return rs, err
+		}
+	}
+	for i, w := range want {
+		gadgetID := matched[i]
+		if gadgetID != 0 {
+			body := dashboardGadgetAPIModel{Title: w.Title, Color: w.Color, Position: w.Position}
+			if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/dashboard/%s/gadget/%d", id, gadgetID), &body, nil); err != nil {
+				return rs, err
+			}
+		} else {
+			var created dashboardGadgetAPIModel
+			if rs, err := callJira(ctx, r.client, http.MethodPost, fmt.Sprintf("rest/api/3/dashboard/%s/gadget", id), w, &created); err != nil {
+				return rs, err
+			}
+			gadgetID = created.ID
+		}
+		if rs, err := r.syncGadgetProperties(ctx, id, gadgetID, w.Properties); err != nil {
+			return rs, err
+		}
+	}
+	return nil, nil
+}
+
+// syncGadgetProperties makes the item properties of a gadget match want; nil leaves them alone.
+func (r *dashboardResource) syncGadgetProperties(ctx context.Context, id string, gadgetID int, want map[string]json.RawMessage) (*models.ResponseScheme, error) {
+	if want == nil {
+		return nil, nil
+	}
+	endpoint := r.gadgetPropertiesEndpoint(id, gadgetID)
+	var keys struct {
+		Keys []struct {
+			Key string `json:"key"`
+		} `json:"keys"`
+	}
+	if rs, err := callJira(ctx, r.client, http.MethodGet, endpoint, nil, &keys); err != nil {
+		return rs, err
+	}
+	for _, k := range keys.Keys {
+		if _, ok := want[k.Key]; ok {
+			continue
+		}
+		if rs, err := callJira(ctx, r.client, http.MethodDelete, endpoint+"/"+url.PathEscape(k.Key), nil, nil); err != nil {
+			return rs, err
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(want)) {
+		if rs, err := callJira(ctx, r.client, http.MethodPut, endpoint+"/"+url.PathEscape(key), want[key], nil); err != nil {
+			return rs, err
+		}
+	}
+	return nil, nil
+}
+
+func (r *dashboardResource) layoutEndpoint(id string) string {
+	return fmt.Sprintf("rest/dashboards/1.0/%s/layout", id)
+}
+
+func (r *dashboardResource) gadgetPropertiesEndpoint(id string, gadgetID int) string {
+	return fmt.Sprintf("rest/api/3/dashboard/%s/items/%d/properties", id, gadgetID)
+}
+
+// buildDashboardPayload converts the planned dashboard. Gadgets stay nil when they are not managed.
+func buildDashboardPayload(ctx context.Context, st *dashboardResourceModel) (*dashboardPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	p := &dashboardPayload{
+		Name:             st.Name.ValueString(),
+		Description:      st.Description.ValueString(),
+		Layout:           st.Layout.ValueString(),
+		SharePermissions: sharePermissionsFromSet(ctx, st.SharePermissions, &diags),
+		EditPermissions:  sharePermissionsFromSet(ctx, st.EditPermissions, &diags),
+	}
+	if st.Gadgets.IsNull() || st.Gadgets.IsUnknown() {
+		return p, diags
+	}
+	var gadgets []dashboardGadgetModel
+	diags.Append(st.Gadgets.ElementsAs(ctx, &gadgets, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	p.Gadgets = make([]*dashboardGadgetAPIModel, 0, len(gadgets))
+	for i, g := range gadgets {
+		gadget := &dashboardGadgetAPIModel{
+			ModuleKey: g.ModuleKey.ValueString(),
+			URI:       g.URI.ValueString(),
+			Title:     g.Title.ValueString(),
+			Color:     g.Color.ValueString(),
+			Position:  dashboardGadgetPosition{Column: int(g.Column.ValueInt64()), Row: int(g.Row.ValueInt64())},
+		}
+		if !g.Properties.IsNull() {
+			if err := json.Unmarshal([]byte(g.Properties.ValueString()), &gadget.Properties); err != nil {
+				diags.AddAttributeError(path.Root("gadgets").AtListIndex(i).AtName("properties"), "Invalid gadget properties", err.Error())
+				continue
+			}
+			if gadget.Properties == nil {
+				gadget.Properties = map[string]json.RawMessage{}
+			}
+		}
+		p.Gadgets = append(p.Gadgets, gadget)
+	}
+	return p, diags
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *dashboardResource) hooks() CRUDHooks[dashboardResourceModel, *dashboardPayload, *dashboardAPIModel] {
+	return CRUDHooks[dashboardResourceModel, *dashboardPayload, *dashboardAPIModel]{
+		BuildPayload:            buildDashboardPayload,
+		APICreate:               r.createDashboard,
+		APIRead:                 r.getDashboard,
+		APIUpdate:               r.updateDashboard,
+		APIDelete:               r.deleteDashboard,
+		ExtractID:               func(st *dashboardResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapDashboardToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *dashboardResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *dashboardResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *dashboardResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *dashboardResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *dashboardResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *dashboardResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *dashboardResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dashboardResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *dashboardResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
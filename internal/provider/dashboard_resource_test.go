@@ -0,0 +1,211 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDashboardResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_dashboard.test"
+	name := acctest.RandomWithPrefix(accPrefixDashboard)
+	cfg := testhelpers.DashboardTmplCfg{
+		ProjectKey:    randomProjectKey(6),
+		Name:          name,
+		LeadAccountID: testhelpers.GetTestProjLeadAcctIdFromEnv(),
+	}
+	updated := cfg
+	updated.Description = "Squad dashboard"
+	updated.Updated = true
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetDashboardCfg(t, cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("share_permissions"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets").AtSliceIndex(0).AtMapKey("title"), knownvalue.StringExact("Open work")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets").AtSliceIndex(1).AtMapKey("color"), knownvalue.StringExact("blue")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets").AtSliceIndex(1).AtMapKey("properties"), knownvalue.Null()),
+				},
+			},
+			{
+				// The filter gadget moves to the new column and the activity stream gadget gives way to a text gadget; the
+				// dashboard itself is updated in place.
+				Config: testhelpers.GetDashboardCfg(t, updated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Squad dashboard")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("layout"), knownvalue.StringExact("AAA")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets").AtSliceIndex(0).AtMapKey("column"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets").AtSliceIndex(0).AtMapKey("color"), knownvalue.StringExact("green")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("gadgets").AtSliceIndex(1).AtMapKey("module_key"), knownvalue.StringExact("com.atlassian.jira.gadgets:text-gadget")),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+				// Gadget properties are only mapped when configured.
+				ImportStateVerifyIgnore: []string{"gadgets.0.properties"},
+			},
+		},
+	})
+}
+
+func TestGadgetPropertiesValue(t *testing.T) {
+	t.Parallel()
+
+	properties := map[string]json.RawMessage{"config": json.RawMessage(`{"num":10,"filterId":"1"}`)}
+	cases := []struct {
+		name  string
+		prior types.String
+		want  string
+	}{
+		{name: "keeps equal prior", prior: types.StringValue(`{ "config": { "filterId": "1", "num": 10 } }`), want: `{ "config": { "filterId": "1", "num": 10 } }`},
+		{name: "replaces changed prior", prior: types.StringValue(`{"config":{"filterId":"1","num":20}}`), want: `{"config":{"filterId":"1","num":10}}`},
+		{name: "replaces unknown prior", prior: types.StringUnknown(), want: `{"config":{"filterId":"1","num":10}}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, diags := gadgetPropertiesValue(properties, tc.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got.ValueString() != tc.want {
+				t.Errorf("got %s, want %s", got.ValueString(), tc.want)
+			}
+		})
+	}
+}
+
+func TestMapDashboardToModelKeepsGadgetOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	gadgetType := types.ObjectType{AttrTypes: (&dashboardGadgetModel{}).AttributeTypes()}
+	prior := []dashboardGadgetModel{
+		{ID: types.StringUnknown(), ModuleKey: types.StringValue("b"), URI: types.StringNull(), Title: types.StringUnknown(), Color: types.StringValue("blue"), Column: types.Int64Value(1), Row: types.Int64Value(0), Properties: types.StringNull()},
+		{ID: types.StringUnknown(), ModuleKey: types.StringValue("a"), URI: types.StringNull(), Title: types.StringUnknown(), Color: types.StringValue("blue"), Column: types.Int64Value(0), Row: types.Int64Value(0), Properties: types.StringValue(`{}`)},
+	}
+	gadgets, diags := types.ListValueFrom(ctx, gadgetType, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	st := dashboardResourceModel{ID: types.StringUnknown(), Gadgets: gadgets}
+	api := &dashboardAPIModel{
+		ID:   "10000",
+		Name: "Team",
+		Gadgets: []*dashboardGadgetAPIModel{
+			{ID: 1, ModuleKey: "a", Title: "A", Color: "blue", Position: dashboardGadgetPosition{Column: 0, Row: 0}},
+			{ID: 3, ModuleKey: "c", Title: "C", Color: "red", Position: dashboardGadgetPosition{Column: 0, Row: 1}},
+			{ID: 2, ModuleKey: "b", Title: "B", Color: "blue", Position: dashboardGadgetPosition{Column: 1, Row: 0}},
+		},
+	}
+	if diags := mapDashboardToModel(ctx, api, &st); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got []dashboardGadgetModel
+	if diags := st.Gadgets.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	wantIDs := []string{"2", "1", "3"}
+	if len(got) != len(wantIDs) {
+		t.Fatalf("got %d gadgets, want %d", len(got), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if got[i].ID.ValueString() != id {
+			t.Errorf("gadget %d has ID %s, want %s", i, got[i].ID.ValueString(), id)
+		}
+	}
+	if got[1].Properties.ValueString() != `{}` || !got[0].Properties.IsNull() || !got[2].Properties.IsNull() {
+		t.Errorf("unexpected properties %v, %v, %v", got[0].Properties, got[1].Properties, got[2].Properties)
+	}
+}
+
+func TestMapDashboardToModelLayout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		reported string
+		prior    types.String
+		want     types.String
+	}{
+		"reported":            {reported: "AB", prior: types.StringValue("AA"), want: types.StringValue("AB")},
+		"unreported keeps":    {prior: types.StringValue("AA"), want: types.StringValue("AA")},
+		"unreported, unknown": {prior: types.StringUnknown(), want: types.StringNull()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			st := dashboardResourceModel{Layout: tc.prior}
+			if diags := mapDashboardToModel(ctx, &dashboardAPIModel{ID: "10000", Name: "Team", Layout: tc.reported}, &st); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !st.Layout.Equal(tc.want) {
+				t.Fatalf("expected layout %s, got %s", tc.want, st.Layout)
+			}
+		})
+	}
+}
+
+func TestUpdateDashboardLayoutWriteIsBestEffort(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/dashboards/1.0/10000/layout" && r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case r.URL.Path == "/rest/dashboards/1.0/10000/layout":
+			_, _ = w.Write([]byte(`{"layout":"AA"}`))
+		case r.URL.Path == "/rest/api/3/dashboard/10000/gadget":
+			_, _ = w.Write([]byte(`{"gadgets":[]}`))
+		case r.URL.Path == "/rest/api/3/dashboard/10000" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":"10000","name":"Team"}`))
+		case r.URL.Path == "/rest/api/3/dashboard/10000":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, err := jira.New(server.Client(), server.URL)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	r := &dashboardResource{}
+	r.client = client
+
+	api, _, err := r.updateDashboard(context.Background(), "10000", &dashboardPayload{Name: "Team", Layout: "AAA"})
+	if err != nil {
+		t.Fatalf("a refused layout write must not fail the update: %v", err)
+	}
+	if api.Layout != "AA" {
+		t.Fatalf("expected the layout Jira kept, got %q", api.Layout)
+	}
+}
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dashboardLayoutColumns maps the dashboard layouts Jira offers to their number of gadget columns. The letters give
+// the relative column widths: AB has a narrow left and a wide right column.
+var dashboardLayoutColumns = map[string]int{
+	"A":   1,
+	"AA":  2,
+	"AB":  2,
+	"BA":  2,
+	"AAA": 3,
+}
+
+// dashboardGadgetColors lists the colours of a gadget frame.
+var dashboardGadgetColors = []string{"blue", "red", "yellow", "green", "cyan", "purple", "gray", "white"}
+
+// dashboardResourceModel models the Terraform schema/state for jira_dashboard.
+type dashboardResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Layout           types.String `tfsdk:"layout"`
+	SharePermissions types.Set    `tfsdk:"share_permissions"`
+	EditPermissions  types.Set    `tfsdk:"edit_permissions"`
+	Gadgets          types.List   `tfsdk:"gadgets"`
+}
+
+// dashboardGadgetModel models a single element of the gadgets list.
+type dashboardGadgetModel struct {
+	ID         types.String `tfsdk:"id"`
+	ModuleKey  types.String `tfsdk:"module_key"`
+	URI        types.String `tfsdk:"uri"`
+	Title      types.String `tfsdk:"title"`
+	Color      types.String `tfsdk:"color"`
+	Column     types.Int64  `tfsdk:"column"`
+	Row        types.Int64  `tfsdk:"row"`
+	Properties types.String `tfsdk:"properties"`
+}
+
+func (m *dashboardGadgetModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"module_key": types.StringType,
+		"uri":        types.StringType,
+		"title":      types.StringType,
+		"color":      types.StringType,
+		"column":     types.Int64Type,
+		"row":        types.Int64Type,
+		"properties": types.StringType,
+	}
+}
+
+// dashboardPayload carries the planned dashboard for create/update. go-atlassian omits empty permission lists and
+// does not wrap gadgets, so the provider defines its own request and response types. Layout and Gadgets are applied
+// through their own endpoints; a nil Gadgets leaves the gadgets of the dashboard alone.
+type dashboardPayload struct {
+	Name             string                     `json:"name"`
+	Description      string                     `json:"description"`
+	SharePermissions []*sharePermissionAPIModel `json:"sharePermissions"`
+	EditPermissions  []*sharePermissionAPIModel `json:"editPermissions"`
+	Layout           string                     `json:"-"`
+	Gadgets          []*dashboardGadgetAPIModel `json:"-"`
+}
+
+// dashboardAPIModel is the dashboard as returned by Jira, together with its layout and gadgets. Layout is empty
+// when Jira did not report it.
+type dashboardAPIModel struct {
+	ID               string                     `json:"id"`
+	Name             string                     `json:"name"`
+	Description      string                     `json:"description"`
+	SharePermissions []*sharePermissionAPIModel `json:"sharePermissions"`
+	EditPermissions  []*sharePermissionAPIModel `json:"editPermissions"`
+	Layout           string                     `json:"-"`
+	Gadgets          []*dashboardGadgetAPIModel `json:"-"`
+}
+
+// dashboardGadgetAPIModel is a gadget of a dashboard. Properties holds the dashboard item properties of the gadget by
+// key; in a payload, nil leaves the properties alone.
+type dashboardGadgetAPIModel struct {
+	ID         int                        `json:"id,omitempty"`
+	ModuleKey  string                     `json:"moduleKey,omitempty"`
+	URI        string                     `json:"uri,omitempty"`
+	Title      string                     `json:"title,omitempty"`
+	Color      string                     `json:"color,omitempty"`
+	Position   dashboardGadgetPosition    `json:"position"`
+	Properties map[string]json.RawMessage `json:"-"`
+}
+
+// dashboardGadgetPosition is the cell of a gadget; a cell holds at most one gadget.
+type dashboardGadgetPosition struct {
+	Column int `json:"column"`
+	Row    int `json:"row"`
+}
+
+// dashboardGadgetPage is the list of gadgets of a dashboard as returned by Jira.
+type dashboardGadgetPage struct {
+	Gadgets []*dashboardGadgetAPIModel `json:"gadgets"`
+}
+
+// sameGadget reports whether have is the gadget want describes, so it can be updated in place.
+func sameGadget(have, want *dashboardGadgetAPIModel) bool {
+	if have.Position != want.Position {
+		return false
+	}
+	if want.ModuleKey != "" {
+		return have.ModuleKey == want.ModuleKey
+	}
+	return have.URI == want.URI
+}
+
+// jsonEqual reports whether a and b hold the same JSON value, ignoring formatting and key order.
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// gadgetPropertiesValue maps the item properties of a gadget to the properties attribute. The configured JSON is kept
+// when it holds the same value, so formatting differences do not show as changes.
+func gadgetPropertiesValue(properties map[string]json.RawMessage, prior types.String) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// Decoding the values first gives them a stable form, with sorted keys and no whitespace.
+	values := make(map[string]any, len(properties))
+	for key, raw := range properties {
+		var v any
+		if err := json.Unmarshal(raw, &v); err != nil {
+			diags.AddError("Failed to decode gadget property", fmt.Sprintf("Property %q: %s", key, err))
+			return types.StringNull(), diags
+		}
+		values[key] = v
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		diags.AddError("Failed to encode gadget properties", err.Error())
+		return types.StringNull(), diags
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonEqual(encoded, []byte(prior.ValueString())) {
+		return prior, diags
+	}
+	return types.StringValue(string(encoded)), diags
+}
+
+// mapDashboardToModel centralizes mapping for the dashboard resource and matches CRUDHooks MapToState signature.
+// Gadgets are kept in the order of st and matched to Jira's by cell; gadgets only found in Jira follow by column and
+// row. Gadgets are only mapped when they are managed, or when importing. Properties are only mapped for gadgets
+// whose properties are managed.
+func mapDashboardToModel(ctx context.Context, api *dashboardAPIModel, st *dashboardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no dashboard payload to map into state.")
+		return diags
+	}
+	share, d := sharePermissionSet(ctx, api.SharePermissions)
+	diags.Append(d...)
+	edit, d := sharePermissionSet(ctx, api.EditPermissions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	gadgetType := types.ObjectType{AttrTypes: (&dashboardGadgetModel{}).AttributeTypes()}
+	gadgets := types.ListNull(gadgetType)
+	importing := st.ID.IsNull()
+	if !st.Gadgets.IsNull() || (importing && len(api.Gadgets) > 0) {
+		var prior []dashboardGadgetModel
+		if !st.Gadgets.IsNull() && !st.Gadgets.IsUnknown() {
+			diags.Append(st.Gadgets.ElementsAs(ctx, &prior, false)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		remaining := slices.Clone(api.Gadgets)
+		slices.SortFunc(remaining, func(a, b *dashboardGadgetAPIModel) int {
+			return cmp.Or(cmp.Compare(a.Position.Column, b.Position.Column), cmp.Compare(a.Position.Row, b.Position.Row))
+		})
+		out := make([]dashboardGadgetModel, 0, len(api.Gadgets))
+		add := func(g *dashboardGadgetAPIModel, properties types.String) {
+			out = append(out, dashboardGadgetModel{
+				ID:         types.StringValue(strconv.Itoa(g.ID)),
+				ModuleKey:  stringOrNull(g.ModuleKey),
+				URI:        stringOrNull(g.URI),
+				Title:      types.StringValue(g.Title),
+				Color:      types.StringValue(g.Color),
+				Column:     types.Int64Value(int64(g.Position.Column)),
+				Row:        types.Int64Value(int64(g.Position.Row)),
+				Properties: properties,
+			})
+		}
+		for _, p := range prior {
+			position := dashboardGadgetPosition{Column: int(p.Column.ValueInt64()), Row: int(p.Row.ValueInt64())}
+			i := slices.IndexFunc(remaining, func(g *dashboardGadgetAPIModel) bool { return g.Position == position })
+			if i < 0 {
+				continue
+			}
+			g := remaining[i]
+			remaining = slices.Delete(remaining, i, i+1)
+			properties := types.StringNull()
+			if !p.Properties.IsNull() {
+				properties, d = gadgetPropertiesValue(g.Properties, p.Properties)
+				diags.Append(d...)
+			}
+			add(g, properties)
+		}
+		for _, g := range remaining {
+			add(g, types.StringNull())
+		}
+		if diags.HasError() {
+			return diags
+		}
+		gadgets, d = types.ListValueFrom(ctx, gadgetType, out)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// An unreported layout keeps the one in state, as the layout endpoint is not part of the public REST API.
+	layout := st.Layout
+	if api.Layout != "" {
+		layout = types.StringValue(api.Layout)
+	} else if layout.IsUnknown() {
+		layout = types.StringNull()
+	}
+
+	*st = dashboardResourceModel{
+		ID:               types.StringValue(api.ID),
+		Name:             types.StringValue(api.Name),
+		Description:      stringOrNull(api.Description),
+		Layout:           layout,
+		SharePermissions: share,
+		EditPermissions:  edit,
+		Gadgets:          gadgets,
+	}
+	return diags
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = jiraDateType{}
+var _ basetypes.StringValuableWithSemanticEquals = jiraDateValue{}
+var _ xattr.ValidateableAttribute = jiraDateValue{}
+
+// jiraDateType is a string attribute type for calendar dates. It accepts YYYY-MM-DD or RFC 3339 timestamps and
+// treats values naming the same day as equal, so a timestamp in configuration does not flap against the
+// YYYY-MM-DD date Jira returns.
+type jiraDateType struct {
+	basetypes.StringType
+}
+
+func (t jiraDateType) String() string { return "jiraDateType" }
+
+func (t jiraDateType) ValueType(_ context.Context) attr.Value { return jiraDateValue{} }
+
+func (t jiraDateType) Equal(o attr.Type) bool {
+	other, ok := o.(jiraDateType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jiraDateType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jiraDateValue{StringValue: in}, nil
+}
+
+func (t jiraDateType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to jiraDateValue: %v", diags)
+	}
+	return value, nil
+}
+
+// jiraDateValue is a value of jiraDateType.
+type jiraDateValue struct {
+	basetypes.StringValue
+}
+
+// newJiraDateValue returns the date Jira reported, or null when it reported none.
+func newJiraDateValue(s string) jiraDateValue {
+	return jiraDateValue{StringValue: stringOrNull(s)}
+}
+
+func (v jiraDateValue) Type(_ context.Context) attr.Type { return jiraDateType{} }
+
+func (v jiraDateValue) Equal(o attr.Value) bool {
+	other, ok := o.(jiraDateValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jiraDateValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(jiraDateValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+	have, err := normalizeJiraDate(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	want, err := normalizeJiraDate(newValue.ValueString())
+	return err == nil && have == want, diags
+}
+
+func (v jiraDateValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if _, err := normalizeJiraDate(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid date", err.Error())
+	}
+}
+
+// jiraDate returns the value as the YYYY-MM-DD date Jira expects, or nil when it is null or unknown.
+func (v jiraDateValue) jiraDate() *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	date, err := normalizeJiraDate(v.ValueString())
+	if err != nil {
+		return nil
+	}
+	return &date
+}
+
+// normalizeJiraDate converts a YYYY-MM-DD date or an RFC 3339 timestamp to YYYY-MM-DD. A timestamp keeps the day
+// of its own offset, so 2025-03-01T23:00:00-05:00 is 2025-03-01.
+func normalizeJiraDate(s string) (string, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t.Format(time.DateOnly), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a YYYY-MM-DD date or an RFC 3339 timestamp", s)
+	}
+	return t.Format(time.DateOnly), nil
+}
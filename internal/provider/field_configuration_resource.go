@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*fieldConfigurationResource)(nil)
+var _ resource.ResourceWithConfigure = (*fieldConfigurationResource)(nil)
+var _ resource.ResourceWithImportState = (*fieldConfigurationResource)(nil)
+
+// fieldConfigurationItemPageSize is the page size used when listing the items of a field configuration.
+const fieldConfigurationItemPageSize = 100
+
+// NewFieldConfigurationResource returns the Terraform resource implementation for jira_field_configuration.
+func NewFieldConfigurationResource() resource.Resource { return &fieldConfigurationResource{} }
+
+type fieldConfigurationResource struct {
+	ServiceClient
+	configService jira.FieldConfigConnector
+	itemService   jira.FieldConfigItemConnector
+	crudRunner    CRUDRunner[fieldConfigurationResourceModel, *fieldConfigurationPayload, *fieldConfigurationAPIModel]
+}
+
+func (r *fieldConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field_configuration"
+}
+
+func (r *fieldConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.configService = provider.client.Issue.Field.Configuration
+	r.itemService = provider.client.Issue.Field.Configuration.Item
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *fieldConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira field configuration, which overrides whether fields are required or hidden, their description and their renderer. Map work types to field configurations with `jira_field_configuration_scheme`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the field configuration. Automatically generated by Jira when the field configuration is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the field configuration. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the field configuration.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"fields": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Map of field ID (for example `summary` or `jira_field.example.id`) to its settings. Only the listed fields are managed; fields configured outside Terraform are left untouched. Removing a field resets it to optional, visible and without a description.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"required": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the field must have a value. Defaults to `false`.",
+						},
+						"hidden": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the field is hidden. A field cannot be both required and hidden. Defaults to `false`.",
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The description shown for the field.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"renderer": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							MarkdownDescription: "The renderer of a text field: `wiki-renderer` or `jira-text-renderer`. When omitted, the current renderer is kept.",
+							Validators:          []validator.String{stringvalidator.OneOf("wiki-renderer", "jira-text-renderer")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *fieldConfigurationResource) createConfiguration(ctx context.Context, p *fieldConfigurationPayload) (*fieldConfigurationAPIModel, *models.ResponseScheme, error) {
+	created, rs, err := r.configService.Create(ctx, p.Name, p.Description)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	id := strconv.Itoa(created.ID)
+	if rs, err := r.putItems(ctx, id, p.Items); err != nil {
+		return nil, rs, err
+	}
+	return r.getConfiguration(ctx, id)
+}
+
+// getConfiguration reads the field configuration and all of its items. Jira has no single-configuration
+// endpoint, so a missing configuration is reported as a synthetic 404.
+func (r *fieldConfigurationResource) getConfiguration(ctx context.Context, id string) (*fieldConfigurationAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid field configuration id %q: %w", id, err)
+	}
+	page, rs, err := r.configService.Gets(ctx, []int{i}, false, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	var config *models.FieldConfigurationScheme
+	for _, v := range page.Values {
+		if v != nil && v.ID == i {
+			config = v
+		}
+	}
+	if config == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("field configuration %s not found", id)
+	}
+
+	api := &fieldConfigurationAPIModel{FieldConfigurationScheme: config, Items: map[string]*models.FieldConfigurationItemScheme{}}
+	for startAt := 0; ; startAt += fieldConfigurationItemPageSize {
+		items, itemsRS, err := r.itemService.Gets(ctx, i, startAt, fieldConfigurationItemPageSize)
+		if err != nil {
+			return nil, itemsRS, err
+		}
+		for _, item := range items.Values {
+			if item != nil {
+				api.Items[item.ID] = item
+			}
+		}
+		if items.IsLast || len(items.Values) == 0 {
+			break
+		}
+	}
+	return api, rs, nil
+}
+
+func (r *fieldConfigurationResource) updateConfiguration(ctx context.Context, id string, p *fieldConfigurationPayload) (*fieldConfigurationAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid field configuration id %q: %w", id, err)
+	}
+	if rs, err := r.configService.Update(ctx, i, p.Name, p.Description); err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.putItems(ctx, id, p.Items); err != nil {
+		return nil, rs, err
+	}
+	return r.getConfiguration(ctx, id)
+}
+
+func (r *fieldConfigurationResource) deleteConfiguration(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field configuration id %q: %w", id, err)
+	}
+	return r.configService.Delete(ctx, i)
+}
+
+// putItems updates the given items in a single request, sent directly because go-atlassian omits false flags.
+func (r *fieldConfigurationResource) putItems(ctx context.Context, id string, items []*fieldConfigurationItem) (*models.ResponseScheme, error) {
+	if len(items) == 0 {
+		return &models.ResponseScheme{Code: http.StatusOK}, nil
+	}
+	body := map[string]any{"fieldConfigurationItems": items}
+	return callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/fieldconfiguration/%s/fields", id), body, nil)
+}
+
+// resetItems resets fields dropped from the fields map to optional, visible and without a description.
+func (r *fieldConfigurationResource) resetItems(ctx context.Context, state, plan *fieldConfigurationResourceModel) (*models.ResponseScheme, error) {
+	if state.Fields.IsNull() || plan.Fields.IsUnknown() {
+		return &models.ResponseScheme{Code: http.StatusOK}, nil
+	}
+	planned := plan.Fields.Elements()
+	var reset []*fieldConfigurationItem
+	for fieldID := range state.Fields.Elements() {
+		if _, ok := planned[fieldID]; !ok {
+			reset = append(reset, &fieldConfigurationItem{ID: fieldID})
+		}
+	}
+	sort.Slice(reset, func(i, j int) bool { return reset[i].ID < reset[j].ID })
+	return r.putItems(ctx, state.ID.ValueString(), reset)
+}
+
+// managedItems narrows the items read from Jira to the fields listed in state, so fields configured outside
+// Terraform never show up as drift.
+func managedItems(_ context.Context, api *fieldConfigurationAPIModel, st *fieldConfigurationResourceModel) (*fieldConfigurationAPIModel, *models.ResponseScheme, error) {
+	managed := st.Fields.Elements()
+	for fieldID := range api.Items {
+		if _, ok := managed[fieldID]; !ok {
+			delete(api.Items, fieldID)
+		}
+	}
+	return api, &models.ResponseScheme{Code: http.StatusOK}, nil
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *fieldConfigurationResource) hooks() CRUDHooks[fieldConfigurationResourceModel, *fieldConfigurationPayload, *fieldConfigurationAPIModel] {
+	return CRUDHooks[fieldConfigurationResourceModel, *fieldConfigurationPayload, *fieldConfigurationAPIModel]{
+		BuildPayload: func(ctx context.Context, st *fieldConfigurationResourceModel) (*fieldConfigurationPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			p := &fieldConfigurationPayload{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}
+			var fields map[string]fieldConfigurationItemModel
+			if !st.Fields.IsNull() && !st.Fields.IsUnknown() {
+				diags.Append(st.Fields.ElementsAs(ctx, &fields, false)...)
+			}
+			for fieldID, f := range fields {
+				p.Items = append(p.Items, &fieldConfigurationItem{
+					ID:          fieldID,
+					IsRequired:  f.Required.ValueBool(),
+					IsHidden:    f.Hidden.ValueBool(),
+					Description: f.Description.ValueString(),
+					Renderer:    f.Renderer.ValueString(),
+				})
+			}
+			// Map iteration order is random; keep requests stable.
+			sort.Slice(p.Items, func(i, j int) bool { return p.Items[i].ID < p.Items[j].ID })
+			return p, diags
+		},
+		APICreate:               r.createConfiguration,
+		APIRead:                 r.getConfiguration,
+		APIUpdate:               r.updateConfiguration,
+		APIDelete:               r.deleteConfiguration,
+		ExtractID:               func(st *fieldConfigurationResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapFieldConfigurationToModel,
+		PostCreate:              managedItems,
+		PostRead:                managedItems,
+		PostUpdate:              managedItems,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *fieldConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldConfigurationResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldConfigurationResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	var state, plan fieldConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rs, err := r.resetItems(ctx, &state, &plan)
+	if !ensureWith(&resp.Diagnostics)(ctx, "reset removed field configuration items", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldConfigurationResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *fieldConfigurationResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
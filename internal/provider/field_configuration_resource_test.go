@@ -0,0 +1,101 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFieldConfigurationResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_field_configuration.test"
+	name := acctest.RandomWithPrefix(accPrefixFieldConfig)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetFieldConfigurationCfg(t, testhelpers.FieldConfigurationTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					// Only the configured field is tracked, not every field of the configuration.
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("fields"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("fields").AtMapKey("environment").AtMapKey("required"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("fields").AtMapKey("environment").AtMapKey("hidden"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: testhelpers.GetFieldConfigurationCfg(t, testhelpers.FieldConfigurationTmplCfg{
+					Name:              name,
+					Description:       "Updated field configuration description",
+					WorkTypeName:      workTypeName,
+					ManageCustomField: true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated field configuration description")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("fields"), knownvalue.MapSizeExact(2)),
+				},
+			},
+			{
+				// Dropping the custom field must stop tracking it without touching the remaining one.
+				Config: testhelpers.GetFieldConfigurationCfg(t, testhelpers.FieldConfigurationTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("fields"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("fields").AtMapKey("environment").AtMapKey("description"), knownvalue.StringExact("Where the problem occurs")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFieldConfigurationSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_field_configuration_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixFieldConfig)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetFieldConfigurationCfg(t, testhelpers.FieldConfigurationTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("default_field_configuration_id"), "jira_field_configuration.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_field_configurations"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetFieldConfigurationCfg(t, testhelpers.FieldConfigurationTmplCfg{Name: name, WorkTypeName: workTypeName, MapWorkType: true}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_field_configurations"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				// Removing the mapping must unlink the work type in Jira.
+				Config: testhelpers.GetFieldConfigurationCfg(t, testhelpers.FieldConfigurationTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_field_configurations"), knownvalue.Null()),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
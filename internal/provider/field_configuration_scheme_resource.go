@@ -0,0 +1,349 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*fieldConfigSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*fieldConfigSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*fieldConfigSchemeResource)(nil)
+
+const (
+	// fieldConfigurationSchemePageSize is the page size used when listing scheme mappings.
+	fieldConfigurationSchemePageSize = 50
+	// fieldConfigurationSchemeDefaultMapping is the pseudo work type ID Jira uses for the default mapping.
+	fieldConfigurationSchemeDefaultMapping = "default"
+)
+
+// NewFieldConfigurationSchemeResource returns the Terraform resource implementation for jira_field_configuration_scheme.
+func NewFieldConfigurationSchemeResource() resource.Resource { return &fieldConfigSchemeResource{} }
+
+type fieldConfigSchemeResource struct {
+	ServiceClient
+	schemeService jira.FieldConfigSchemeConnector
+	crudRunner    CRUDRunner[fieldConfigurationSchemeResourceModel, *fieldConfigurationSchemePayload, *fieldConfigurationSchemeAPIModel]
+}
+
+func (r *fieldConfigSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field_configuration_scheme"
+}
+
+func (r *fieldConfigSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.schemeService = provider.client.Issue.Field.Configuration.Scheme
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *fieldConfigSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira field configuration scheme, which selects the field configuration used for each work type in the projects that use it. Assign it to a project with `field_configuration_scheme_id` on `jira_project`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the field configuration scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the field configuration scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the field configuration scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(1024)},
+			},
+			"default_field_configuration_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the field configuration used for work types without an explicit mapping (for example `jira_field_configuration.example.id`).",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric field configuration ID")},
+			},
+			"work_type_field_configurations": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Map of work type ID (for example `jira_work_type.example.id`) to field configuration ID.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric work type ID")),
+					mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric field configuration ID")),
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *fieldConfigSchemeResource) createScheme(ctx context.Context, p *fieldConfigurationSchemePayload) (*fieldConfigurationSchemeAPIModel, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p.Name, p.Description)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	if rs, err := r.linkMappings(ctx, created.ID, p, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, created.ID)
+}
+
+// getScheme reads the scheme and its mappings. Jira has no single-scheme endpoint, so a missing scheme is
+// reported as a synthetic 404.
+func (r *fieldConfigSchemeResource) getScheme(ctx context.Context, id string) (*fieldConfigurationSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid field configuration scheme id %q: %w", id, err)
+	}
+	page, rs, err := r.schemeService.Gets(ctx, []int{i}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	var scheme *models.FieldConfigurationSchemeScheme
+	for _, v := range page.Values {
+		if v != nil && v.ID == id {
+			scheme = v
+		}
+	}
+	if scheme == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("field configuration scheme %s not found", id)
+	}
+
+	api := &fieldConfigurationSchemeAPIModel{FieldConfigurationSchemeScheme: scheme, WorkTypeFieldConfigurations: map[string]string{}}
+	for startAt := 0; ; startAt += fieldConfigurationSchemePageSize {
+		items, itemsRS, err := r.schemeService.Mapping(ctx, []int{i}, startAt, fieldConfigurationSchemePageSize)
+		if err != nil {
+			return nil, itemsRS, err
+		}
+		for _, item := range items.Values {
+			if item == nil || item.FieldConfigurationSchemeID != id {
+				continue
+			}
+			if item.IssueTypeID == fieldConfigurationSchemeDefaultMapping {
+				api.DefaultFieldConfigurationID = item.FieldConfigurationID
+				continue
+			}
+			api.WorkTypeFieldConfigurations[item.IssueTypeID] = item.FieldConfigurationID
+		}
+		if items.IsLast || len(items.Values) == 0 {
+			break
+		}
+	}
+	return api, rs, nil
+}
+
+// updateScheme updates the metadata, then links new and changed mappings and unlinks removed ones. Jira replaces
+// a linked mapping in place, so only removed work types need a separate call.
+func (r *fieldConfigSchemeResource) updateScheme(ctx context.Context, id string, p *fieldConfigurationSchemePayload) (*fieldConfigurationSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid field configuration scheme id %q: %w", id, err)
+	}
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.schemeService.Update(ctx, i, p.Name, p.Description); err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.linkMappings(ctx, id, p, current); err != nil {
+		return nil, rs, err
+	}
+
+	var removed []string
+	for workTypeID := range current.WorkTypeFieldConfigurations {
+		if _, ok := p.WorkTypeFieldConfigurations[workTypeID]; !ok {
+			removed = append(removed, workTypeID)
+		}
+	}
+	if len(removed) > 0 {
+		sort.Strings(removed)
+		if rs, err := r.schemeService.Unlink(ctx, i, removed); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getScheme(ctx, id)
+}
+
+// linkMappings links the planned default and work type mappings that differ from current in a single request.
+func (r *fieldConfigSchemeResource) linkMappings(ctx context.Context, id string, p *fieldConfigurationSchemePayload, current *fieldConfigurationSchemeAPIModel) (*models.ResponseScheme, error) {
+	if current == nil {
+		current = &fieldConfigurationSchemeAPIModel{}
+	}
+	var mappings []*models.FieldConfigurationToIssueTypeMappingScheme
+	if p.DefaultFieldConfigurationID != current.DefaultFieldConfigurationID {
+		mappings = append(mappings, &models.FieldConfigurationToIssueTypeMappingScheme{
+			IssueTypeID:          fieldConfigurationSchemeDefaultMapping,
+			FieldConfigurationID: p.DefaultFieldConfigurationID,
+		})
+	}
+	workTypeIDs := make([]string, 0, len(p.WorkTypeFieldConfigurations))
+	for workTypeID := range p.WorkTypeFieldConfigurations {
+		workTypeIDs = append(workTypeIDs, workTypeID)
+	}
+	sort.Strings(workTypeIDs)
+	for _, workTypeID := range workTypeIDs {
+		configID := p.WorkTypeFieldConfigurations[workTypeID]
+		if current.WorkTypeFieldConfigurations[workTypeID] == configID {
+			continue
+		}
+		mappings = append(mappings, &models.FieldConfigurationToIssueTypeMappingScheme{
+			IssueTypeID:          workTypeID,
+			FieldConfigurationID: configID,
+		})
+	}
+	if len(mappings) == 0 {
+		return &models.ResponseScheme{Code: http.StatusOK}, nil
+	}
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field configuration scheme id %q: %w", id, err)
+	}
+	return r.schemeService.Link(ctx, i, &models.FieldConfigurationToIssueTypeMappingPayloadScheme{Mappings: mappings})
+}
+
+func (r *fieldConfigSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field configuration scheme id %q: %w", id, err)
+	}
+	return r.schemeService.Delete(ctx, i)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *fieldConfigSchemeResource) hooks() CRUDHooks[fieldConfigurationSchemeResourceModel, *fieldConfigurationSchemePayload, *fieldConfigurationSchemeAPIModel] {
+	return CRUDHooks[fieldConfigurationSchemeResourceModel, *fieldConfigurationSchemePayload, *fieldConfigurationSchemeAPIModel]{
+		BuildPayload: func(ctx context.Context, st *fieldConfigurationSchemeResourceModel) (*fieldConfigurationSchemePayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			p := &fieldConfigurationSchemePayload{
+				Name:                        st.Name.ValueString(),
+				Description:                 st.Description.ValueString(),
+				DefaultFieldConfigurationID: st.DefaultFieldConfigurationID.ValueString(),
+				WorkTypeFieldConfigurations: map[string]string{},
+			}
+			if !st.WorkTypeFieldConfigurations.IsNull() && !st.WorkTypeFieldConfigurations.IsUnknown() {
+				diags.Append(st.WorkTypeFieldConfigurations.ElementsAs(ctx, &p.WorkTypeFieldConfigurations, false)...)
+			}
+			return p, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *fieldConfigurationSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapFieldConfigurationSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *fieldConfigSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldConfigSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
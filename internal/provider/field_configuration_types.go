@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fieldConfigurationResourceModel models the Terraform schema/state for jira_field_configuration.
+type fieldConfigurationResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Fields      types.Map    `tfsdk:"fields"`
+}
+
+// fieldConfigurationItemModel models a single element of the fields map.
+type fieldConfigurationItemModel struct {
+	Required    types.Bool   `tfsdk:"required"`
+	Hidden      types.Bool   `tfsdk:"hidden"`
+	Description types.String `tfsdk:"description"`
+	Renderer    types.String `tfsdk:"renderer"`
+}
+
+func (m *fieldConfigurationItemModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"required":    types.BoolType,
+		"hidden":      types.BoolType,
+		"description": types.StringType,
+		"renderer":    types.StringType,
+	}
+}
+
+func (m *fieldConfigurationResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"fields":      types.MapType{ElemType: types.ObjectType{AttrTypes: (&fieldConfigurationItemModel{}).AttributeTypes()}},
+	}
+}
+
+// fieldConfigurationItem is a field configuration item as sent to Jira. go-atlassian omits false flags and empty
+// descriptions, which Jira reads as "unchanged", so the provider sends its own items.
+type fieldConfigurationItem struct {
+	ID          string `json:"id"`
+	IsHidden    bool   `json:"isHidden"`
+	IsRequired  bool   `json:"isRequired"`
+	Description string `json:"description"`
+	Renderer    string `json:"renderer,omitempty"`
+}
+
+// fieldConfigurationPayload carries the planned field configuration and its managed items for create/update.
+type fieldConfigurationPayload struct {
+	Name        string
+	Description string
+	Items       []*fieldConfigurationItem
+}
+
+// fieldConfigurationAPIModel wraps the go-atlassian field configuration with its items keyed by field ID. Jira
+// serves the items from a separate endpoint; the post hooks narrow them to the fields managed in state.
+type fieldConfigurationAPIModel struct {
+	*models.FieldConfigurationScheme
+	Items map[string]*models.FieldConfigurationItemScheme
+}
+
+// mapFieldConfigurationToModel centralizes mapping for the field configuration resource and matches CRUDHooks MapToState signature.
+func mapFieldConfigurationToModel(ctx context.Context, api *fieldConfigurationAPIModel, st *fieldConfigurationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.FieldConfigurationScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no field configuration payload to map into state.")
+		return diags
+	}
+
+	itemType := types.ObjectType{AttrTypes: (&fieldConfigurationItemModel{}).AttributeTypes()}
+	fields := types.MapNull(itemType)
+	if len(api.Items) > 0 {
+		items := make(map[string]fieldConfigurationItemModel, len(api.Items))
+		for id, item := range api.Items {
+			items[id] = fieldConfigurationItemModel{
+				Required:    types.BoolValue(item.IsRequired),
+				Hidden:      types.BoolValue(item.IsHidden),
+				Description: stringOrNull(item.Description),
+				Renderer:    stringOrNull(item.Renderer),
+			}
+		}
+		var d diag.Diagnostics
+		fields, d = types.MapValueFrom(ctx, itemType, items)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*st = fieldConfigurationResourceModel{
+		ID:          types.StringValue(strconv.Itoa(api.ID)),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+		Fields:      fields,
+	}
+	return diags
+}
+
+// fieldConfigurationSchemeResourceModel models the Terraform schema/state for jira_field_configuration_scheme.
+type fieldConfigurationSchemeResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	DefaultFieldConfigurationID types.String `tfsdk:"default_field_configuration_id"`
+	WorkTypeFieldConfigurations types.Map    `tfsdk:"work_type_field_configurations"`
+}
+
+func (m *fieldConfigurationSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                             types.StringType,
+		"name":                           types.StringType,
+		"description":                    types.StringType,
+		"default_field_configuration_id": types.StringType,
+		"work_type_field_configurations": types.MapType{ElemType: types.StringType},
+	}
+}
+
+// fieldConfigurationSchemePayload carries the planned scheme for create/update. go-atlassian takes the name and
+// description positionally and the mappings in a separate call, so the provider defines its own payload.
+type fieldConfigurationSchemePayload struct {
+	Name                        string
+	Description                 string
+	DefaultFieldConfigurationID string
+	WorkTypeFieldConfigurations map[string]string
+}
+
+// fieldConfigurationSchemeAPIModel wraps the go-atlassian field configuration scheme with its mappings, which Jira
+// serves from a separate endpoint. DefaultFieldConfigurationID holds the mapping for the "default" pseudo work
+// type, WorkTypeFieldConfigurations the rest.
+type fieldConfigurationSchemeAPIModel struct {
+	*models.FieldConfigurationSchemeScheme
+	DefaultFieldConfigurationID string
+	WorkTypeFieldConfigurations map[string]string
+}
+
+// mapFieldConfigurationSchemeToModel centralizes mapping for the field configuration scheme resource and matches CRUDHooks MapToState signature.
+func mapFieldConfigurationSchemeToModel(ctx context.Context, api *fieldConfigurationSchemeAPIModel, st *fieldConfigurationSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.FieldConfigurationSchemeScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no field configuration scheme payload to map into state.")
+		return diags
+	}
+
+	mappings := types.MapNull(types.StringType)
+	if len(api.WorkTypeFieldConfigurations) > 0 {
+		var d diag.Diagnostics
+		mappings, d = types.MapValueFrom(ctx, types.StringType, api.WorkTypeFieldConfigurations)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*st = fieldConfigurationSchemeResourceModel{
+		ID:                          types.StringValue(api.ID),
+		Name:                        types.StringValue(api.Name),
+		Description:                 stringOrNull(api.Description),
+		DefaultFieldConfigurationID: stringOrNull(api.DefaultFieldConfigurationID),
+		WorkTypeFieldConfigurations: mappings,
+	}
+	return diags
+}
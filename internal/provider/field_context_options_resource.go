@@ -0,0 +1,698 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*fieldContextOptionsResource)(nil)
+var _ resource.ResourceWithConfigure = (*fieldContextOptionsResource)(nil)
+var _ resource.ResourceWithImportState = (*fieldContextOptionsResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*fieldContextOptionsResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*fieldContextOptionsResource)(nil)
+
+// fieldContextOptionPageSize is the page size used when listing the options of a context.
+const fieldContextOptionPageSize = 100
+
+// NewFieldContextOptionsResource returns the Terraform resource implementation for jira_field_context_options.
+func NewFieldContextOptionsResource() resource.Resource { return &fieldContextOptionsResource{} }
+
+type fieldContextOptionsResource struct {
+	ServiceClient
+	contextService jira.FieldContextConnector
+	optionService  jira.FieldContextOptionConnector
+	crudRunner     CRUDRunner[fieldContextOptionsResourceModel, *fieldContextOptionsPayload, *fieldContextOptionsAPIModel]
+}
+
+func (r *fieldContextOptionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field_context_options"
+}
+
+func (r *fieldContextOptionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.contextService = provider.client.Issue.Field.Context
+	r.optionService = provider.client.Issue.Field.Context.Option
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *fieldContextOptionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the ordered options of a select, radio button, checkbox or cascading select field in one `jira_field_context`. Options are matched by value, so their IDs stay stable when the list is reordered; renaming an option deletes it and creates a new one with a new ID, and options of the context missing from the list are deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The identifier of the resource in the form `field_id/context_id`.",
+			},
+			"field_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the custom field the context belongs to. Changing this forces a new resource.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"context_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the context whose options are managed (for example `jira_field_context.example.id`). Changing this forces a new resource.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric context ID")},
+			},
+			"options": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The options of the context, in display order. Values must be unique.",
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The option value. Changing the value replaces the option, so work items lose the old value.",
+							Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+						},
+						"disabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the option is hidden from new selections. Defaults to `false`.",
+						},
+						"default": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the option is selected by default. Only multi-select and checkbox fields accept more than one default option. Defaults to `false`.",
+						},
+						"children": schema.ListNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "The child options, in display order. Only supported by cascading select fields.",
+							Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The child option value. Values must be unique within the parent option.",
+										Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+									},
+									"disabled": schema.BoolAttribute{
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(false),
+										MarkdownDescription: "Whether the child option is hidden from new selections. Defaults to `false`.",
+									},
+									"default": schema.BoolAttribute{
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(false),
+										MarkdownDescription: "Whether the child option is selected by default. Requires `default` on its parent option. Defaults to `false`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"option_ids": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "IDs of the options, keyed by option value. Known at plan time unless an option value is added or renamed.",
+			},
+			"child_option_ids": schema.MapAttribute{
+				ElementType:         types.MapType{ElemType: types.StringType},
+				Computed:            true,
+				MarkdownDescription: "IDs of the child options, keyed by parent option value and then by child option value.",
+			},
+		},
+	}
+}
+
+// ModifyPlan carries the option IDs over from state when every planned option and child option already exists, so
+// reordering options or changing their flags does not plan the ID maps as unknown. Any new value leaves them unknown.
+func (r *fieldContextOptionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state fieldContextOptionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Options.IsUnknown() || !plan.FieldID.Equal(state.FieldID) || !plan.ContextID.Equal(state.ContextID) {
+		return
+	}
+
+	var options []fieldContextOptionModel
+	stateOptionIDs := map[string]string{}
+	stateChildOptionIDs := map[string]map[string]string{}
+	resp.Diagnostics.Append(plan.Options.ElementsAs(ctx, &options, false)...)
+	resp.Diagnostics.Append(state.OptionIDs.ElementsAs(ctx, &stateOptionIDs, false)...)
+	resp.Diagnostics.Append(state.ChildOptionIDs.ElementsAs(ctx, &stateChildOptionIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optionIDs := make(map[string]string, len(options))
+	childOptionIDs := map[string]map[string]string{}
+	for _, o := range options {
+		id, ok := stateOptionIDs[o.Value.ValueString()]
+		if o.Value.IsUnknown() || o.Children.IsUnknown() || !ok {
+			return
+		}
+		optionIDs[o.Value.ValueString()] = id
+		if o.Children.IsNull() {
+			continue
+		}
+		var children []fieldContextChildOptionModel
+		resp.Diagnostics.Append(o.Children.ElementsAs(ctx, &children, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ids := make(map[string]string, len(children))
+		for _, c := range children {
+			id, ok := stateChildOptionIDs[o.Value.ValueString()][c.Value.ValueString()]
+			if c.Value.IsUnknown() || !ok {
+				return
+			}
+			ids[c.Value.ValueString()] = id
+		}
+		childOptionIDs[o.Value.ValueString()] = ids
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("option_ids"), optionIDs)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("child_option_ids"), childOptionIDs)...)
+}
+
+// ValidateConfig checks value uniqueness and the placement of default flags, which the schema cannot express.
+func (r *fieldContextOptionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg fieldContextOptionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || cfg.Options.IsNull() || cfg.Options.IsUnknown() {
+		return
+	}
+	var options []fieldContextOptionModel
+	resp.Diagnostics.Append(cfg.Options.ElementsAs(ctx, &options, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]struct{}{}
+	for i, o := range options {
+		optionPath := path.Root("options").AtListIndex(i)
+		if !o.Value.IsUnknown() {
+			if _, dup := seen[o.Value.ValueString()]; dup {
+				resp.Diagnostics.AddAttributeError(optionPath.AtName("value"), "Duplicate option value",
+					fmt.Sprintf("The option value %q appears more than once; option values must be unique.", o.Value.ValueString()))
+			}
+			seen[o.Value.ValueString()] = struct{}{}
+		}
+		if o.Children.IsNull() || o.Children.IsUnknown() {
+			continue
+		}
+		var children []fieldContextChildOptionModel
+		resp.Diagnostics.Append(o.Children.ElementsAs(ctx, &children, false)...)
+		seenChildren := map[string]struct{}{}
+		defaults := 0
+		for j, c := range children {
+			childPath := optionPath.AtName("children").AtListIndex(j)
+			if !c.Value.IsUnknown() {
+				if _, dup := seenChildren[c.Value.ValueString()]; dup {
+					resp.Diagnostics.AddAttributeError(childPath.AtName("value"), "Duplicate child option value",
+						fmt.Sprintf("The child option value %q appears more than once under %q; child option values must be unique within their parent.", c.Value.ValueString(), o.Value.ValueString()))
+				}
+				seenChildren[c.Value.ValueString()] = struct{}{}
+			}
+			if !c.Default.ValueBool() {
+				continue
+			}
+			defaults++
+			if defaults > 1 {
+				resp.Diagnostics.AddAttributeError(childPath.AtName("default"), "Multiple default child options",
+					"At most one child option can be the default.")
+			}
+			if !o.Default.IsUnknown() && !o.Default.ValueBool() {
+				resp.Diagnostics.AddAttributeError(childPath.AtName("default"), "Default child option under a non-default parent",
+					fmt.Sprintf("A default child option requires default = true on its parent option %q.", o.Value.ValueString()))
+			}
+		}
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *fieldContextOptionsResource) createOptions(ctx context.Context, p *fieldContextOptionsPayload) (*fieldContextOptionsAPIModel, *models.ResponseScheme, error) {
+	key := p.FieldID + "/" + strconv.Itoa(p.ContextID)
+	// The context may already hold options, e.g. ones Jira created with the field; they are adopted by value.
+	return r.updateOptions(ctx, key, p)
+}
+
+// getOptions lists the options of the context in display order, nesting child options under their parents, and
+// resolves the default flags from the context's default value.
+func (r *fieldContextOptionsResource) getOptions(ctx context.Context, key string) (*fieldContextOptionsAPIModel, *models.ResponseScheme, error) {
+	fieldID, contextID, err := parseFieldContextKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	api := &fieldContextOptionsAPIModel{FieldID: fieldID, ContextID: contextID}
+	byID := map[string]*fieldOption{}
+	var children []*models.CustomFieldContextOptionScheme
+	var rs *models.ResponseScheme
+	for startAt := 0; ; startAt += fieldContextOptionPageSize {
+		page, pageRS, err := r.optionService.Gets(ctx, fieldID, contextID, nil, startAt, fieldContextOptionPageSize)
+		if err != nil {
+			return nil, pageRS, err
+		}
+		rs = pageRS
+		for _, o := range page.Values {
+			if o == nil {
+				continue
+			}
+			if o.OptionID != "" {
+				children = append(children, o)
+				continue
+			}
+			option := &fieldOption{ID: o.ID, Value: o.Value, Disabled: o.Disabled}
+			byID[o.ID] = option
+			api.Options = append(api.Options, option)
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	for _, c := range children {
+		if parent, ok := byID[c.OptionID]; ok {
+			child := &fieldOption{ID: c.ID, Value: c.Value, Disabled: c.Disabled}
+			byID[c.ID] = child
+			parent.Children = append(parent.Children, child)
+		}
+	}
+
+	defaults, rs, err := r.contextService.GetDefaultValues(ctx, fieldID, []int{contextID}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, d := range defaults.Values {
+		if d == nil || d.ContextID != strconv.Itoa(contextID) {
+			continue
+		}
+		for _, id := range append([]string{d.OptionID, d.CascadingOptionID}, d.OptionIDs...) {
+			if option, ok := byID[id]; ok {
+				option.Default = true
+			}
+		}
+	}
+	return api, rs, nil
+}
+
+// updateOptions reconciles the context's options against the plan: options are matched by value, so existing
+// options keep their IDs, new ones are created, changed ones updated and unlisted ones deleted. A renamed option is
+// therefore recreated, as the plan cannot tell a rename from replacing one option with another. The options are
+// then put in the planned order and the default value is set.
+func (r *fieldContextOptionsResource) updateOptions(ctx context.Context, key string, p *fieldContextOptionsPayload) (*fieldContextOptionsAPIModel, *models.ResponseScheme, error) {
+	current, rs, err := r.getOptions(ctx, key)
+	if err != nil {
+		return nil, rs, err
+	}
+	currentByValue := make(map[string]*fieldOption, len(current.Options))
+	for _, o := range current.Options {
+		currentByValue[o.Value] = o
+	}
+
+	// Parents first, so that new children can reference their parent's ID.
+	var created, changed []*models.CustomFieldContextOptionScheme
+	for _, o := range p.Options {
+		if existing, ok := currentByValue[o.Value]; ok {
+			o.ID = existing.ID
+			if existing.Disabled != o.Disabled {
+				changed = append(changed, &models.CustomFieldContextOptionScheme{ID: o.ID, Value: o.Value, Disabled: o.Disabled})
+			}
+			continue
+		}
+		created = append(created, &models.CustomFieldContextOptionScheme{Value: o.Value, Disabled: o.Disabled})
+	}
+	if rs, err := r.createOptionBatch(ctx, p, created, func(o *models.CustomFieldContextOptionScheme) *fieldOption {
+		return findFieldOption(p.Options, o.Value)
+	}); err != nil {
+		return nil, rs, err
+	}
+
+	created = nil
+	for _, o := range p.Options {
+		var existingChildren []*fieldOption
+		if existing, ok := currentByValue[o.Value]; ok {
+			existingChildren = existing.Children
+		}
+		for _, c := range o.Children {
+			if existing := findFieldOption(existingChildren, c.Value); existing != nil {
+				c.ID = existing.ID
+				if existing.Disabled != c.Disabled {
+					changed = append(changed, &models.CustomFieldContextOptionScheme{ID: c.ID, Value: c.Value, Disabled: c.Disabled})
+				}
+				continue
+			}
+			created = append(created, &models.CustomFieldContextOptionScheme{Value: c.Value, Disabled: c.Disabled, OptionID: o.ID})
+		}
+	}
+	if rs, err := r.createOptionBatch(ctx, p, created, func(o *models.CustomFieldContextOptionScheme) *fieldOption {
+		parent := findFieldOptionByID(p.Options, o.OptionID)
+		if parent == nil {
+			return nil
+		}
+		return findFieldOption(parent.Children, o.Value)
+	}); err != nil {
+		return nil, rs, err
+	}
+
+	if len(changed) > 0 {
+		if _, rs, err := r.optionService.Update(ctx, p.FieldID, p.ContextID, &models.FieldContextOptionListScheme{Options: changed}); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	// Deleting a parent option also deletes its children.
+	for _, o := range current.Options {
+		planned := findFieldOption(p.Options, o.Value)
+		if planned == nil {
+			if rs, err := r.deleteOption(ctx, p, o.ID); err != nil {
+				return nil, rs, err
+			}
+			continue
+		}
+		for _, c := range o.Children {
+			if findFieldOption(planned.Children, c.Value) == nil {
+				if rs, err := r.deleteOption(ctx, p, c.ID); err != nil {
+					return nil, rs, err
+				}
+			}
+		}
+	}
+
+	if rs, err := r.orderOptions(ctx, p, p.Options); err != nil {
+		return nil, rs, err
+	}
+	for _, o := range p.Options {
+		if rs, err := r.orderOptions(ctx, p, o.Children); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	if rs, err := r.setDefaultOptions(ctx, p, current.Options); err != nil {
+		return nil, rs, err
+	}
+	return r.getOptions(ctx, key)
+}
+
+// createOptionBatch creates options in one request and records the returned IDs on the planned options that
+// match lookup.
+func (r *fieldContextOptionsResource) createOptionBatch(ctx context.Context, p *fieldContextOptionsPayload, options []*models.CustomFieldContextOptionScheme, lookup func(*models.CustomFieldContextOptionScheme) *fieldOption) (*models.ResponseScheme, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+	created, rs, err := r.optionService.Create(ctx, p.FieldID, p.ContextID, &models.FieldContextOptionListScheme{Options: options})
+	if err != nil {
+		return rs, err
+	}
+	for _, o := range created.Options {
+		if planned := lookup(o); planned != nil {
+			planned.ID = o.ID
+		}
+	}
+	return rs, nil
+}
+
+func (r *fieldContextOptionsResource) deleteOption(ctx context.Context, p *fieldContextOptionsPayload, id string) (*models.ResponseScheme, error) {
+	optionID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid option id %q: %w", id, err)
+	}
+	return r.optionService.Delete(ctx, p.FieldID, p.ContextID, optionID)
+}
+
+// orderOptions moves sibling options to the top of their list in the given order.
+func (r *fieldContextOptionsResource) orderOptions(ctx context.Context, p *fieldContextOptionsPayload, options []*fieldOption) (*models.ResponseScheme, error) {
+	if len(options) < 2 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(options))
+	for _, o := range options {
+		ids = append(ids, o.ID)
+	}
+	return r.optionService.Order(ctx, p.FieldID, p.ContextID, &models.OrderFieldOptionPayloadScheme{
+		Position:             "First",
+		CustomFieldOptionIDs: ids,
+	})
+}
+
+// setDefaultOptions sets the context default from the planned default flags, or clears it when no option is a
+// default. The default value type depends on the field type, so the field is looked up first; nothing is sent
+// when neither the current nor the planned options carry a default.
+func (r *fieldContextOptionsResource) setDefaultOptions(ctx context.Context, p *fieldContextOptionsPayload, current []*fieldOption) (*models.ResponseScheme, error) {
+	hasDefault := func(options []*fieldOption) bool {
+		return slices.ContainsFunc(options, func(o *fieldOption) bool { return o.Default })
+	}
+	if !hasDefault(p.Options) && !hasDefault(current) {
+		return nil, nil
+	}
+	if slices.EqualFunc(defaultOptionIDs(p.Options), defaultOptionIDs(current), func(a, b string) bool { return a == b }) {
+		return nil, nil
+	}
+
+	fieldType, rs, err := lookupFieldType(ctx, r.client, p.FieldID)
+	if err != nil {
+		return rs, err
+	}
+	defaultType, ok := fieldOptionDefaultTypes[fieldType]
+	if !ok {
+		return nil, fmt.Errorf("default options are not supported for %q fields", fieldType)
+	}
+
+	defaultValue := map[string]any{"contextId": strconv.Itoa(p.ContextID), "type": defaultType}
+	ids := defaultOptionIDs(p.Options)
+	switch defaultType {
+	case fieldDefaultTypeOptionMultiple:
+		defaultValue["optionIds"] = ids
+	case fieldDefaultTypeOptionCascading:
+		defaultValue["optionId"], defaultValue["cascadingOptionId"] = nil, nil
+		for _, o := range p.Options {
+			if !o.Default {
+				continue
+			}
+			defaultValue["optionId"] = o.ID
+			for _, c := range o.Children {
+				if c.Default {
+					defaultValue["cascadingOptionId"] = c.ID
+				}
+			}
+		}
+	default:
+		if len(ids) > 1 {
+			return nil, fmt.Errorf("%q fields accept a single default option, got %d", fieldType, len(ids))
+		}
+		defaultValue["optionId"] = nil
+		if len(ids) == 1 {
+			defaultValue["optionId"] = ids[0]
+		}
+	}
+	return putFieldContextDefault(ctx, r.client, p.FieldID, defaultValue)
+}
+
+// deleteOptions deletes every option of the context; deleting a parent option also deletes its children.
+func (r *fieldContextOptionsResource) deleteOptions(ctx context.Context, key string) (*models.ResponseScheme, error) {
+	current, rs, err := r.getOptions(ctx, key)
+	if err != nil {
+		return rs, err
+	}
+	p := &fieldContextOptionsPayload{FieldID: current.FieldID, ContextID: current.ContextID}
+	for _, o := range current.Options {
+		if rs, err := r.deleteOption(ctx, p, o.ID); err != nil {
+			return rs, err
+		}
+	}
+	return rs, nil
+}
+
+// findFieldOption returns the option with the given value, or nil.
+func findFieldOption(options []*fieldOption, value string) *fieldOption {
+	for _, o := range options {
+		if o.Value == value {
+			return o
+		}
+	}
+	return nil
+}
+
+// findFieldOptionByID returns the option with the given ID, or nil.
+func findFieldOptionByID(options []*fieldOption, id string) *fieldOption {
+	for _, o := range options {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// defaultOptionIDs returns the IDs of the default options and child options, in order.
+func defaultOptionIDs(options []*fieldOption) []string {
+	ids := []string{}
+	for _, o := range options {
+		if o.Default {
+			ids = append(ids, o.ID)
+		}
+		for _, c := range o.Children {
+			if c.Default {
+				ids = append(ids, c.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *fieldContextOptionsResource) hooks() CRUDHooks[fieldContextOptionsResourceModel, *fieldContextOptionsPayload, *fieldContextOptionsAPIModel] {
+	return CRUDHooks[fieldContextOptionsResourceModel, *fieldContextOptionsPayload, *fieldContextOptionsAPIModel]{
+		BuildPayload: func(ctx context.Context, st *fieldContextOptionsResourceModel) (*fieldContextOptionsPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			contextID, err := strconv.Atoi(st.ContextID.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("context_id"), "Invalid context ID", err.Error())
+				return nil, diags
+			}
+			p := &fieldContextOptionsPayload{FieldID: st.FieldID.ValueString(), ContextID: contextID}
+
+			var options []fieldContextOptionModel
+			diags.Append(st.Options.ElementsAs(ctx, &options, false)...)
+			for _, o := range options {
+				option := &fieldOption{Value: o.Value.ValueString(), Disabled: o.Disabled.ValueBool(), Default: o.Default.ValueBool()}
+				if !o.Children.IsNull() && !o.Children.IsUnknown() {
+					var children []fieldContextChildOptionModel
+					diags.Append(o.Children.ElementsAs(ctx, &children, false)...)
+					for _, c := range children {
+						option.Children = append(option.Children, &fieldOption{Value: c.Value.ValueString(), Disabled: c.Disabled.ValueBool(), Default: c.Default.ValueBool()})
+					}
+				}
+				p.Options = append(p.Options, option)
+			}
+			return p, diags
+		},
+		APICreate:               r.createOptions,
+		APIRead:                 r.getOptions,
+		APIUpdate:               r.updateOptions,
+		APIDelete:               r.deleteOptions,
+		ExtractID:               func(st *fieldContextOptionsResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapFieldContextOptionsToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *fieldContextOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextOptionsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *fieldContextOptionsResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,502 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*fieldContextResource)(nil)
+var _ resource.ResourceWithConfigure = (*fieldContextResource)(nil)
+var _ resource.ResourceWithImportState = (*fieldContextResource)(nil)
+
+// fieldContextPageSize is the page size used when listing contexts and their project and work type mappings.
+const fieldContextPageSize = 50
+
+// NewFieldContextResource returns the Terraform resource implementation for jira_field_context.
+func NewFieldContextResource() resource.Resource { return &fieldContextResource{} }
+
+type fieldContextResource struct {
+	ServiceClient
+	contextService jira.FieldContextConnector
+	crudRunner     CRUDRunner[fieldContextResourceModel, *fieldContextPayload, *fieldContextAPIModel]
+}
+
+func (r *fieldContextResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field_context"
+}
+
+func (r *fieldContextResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.contextService = provider.client.Issue.Field.Context
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+// requiresReplaceIfScopeToggled forces a new context when a set switches between null and non-null: Jira cannot
+// turn a global context into a project context (or back) in place, nor switch between any and specific work types.
+func requiresReplaceIfScopeToggled(attr string) planmodifier.Set {
+	description := fmt.Sprintf("Adding or removing %s forces a new context.", attr)
+	return setplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+		},
+		description,
+		description,
+	)
+}
+
+func (r *fieldContextResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom field context, which scopes a `jira_field` to projects and work types and holds its default value and options. Manage the options of select-type fields with `jira_field_context_options`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the context. Automatically generated by Jira when the context is created.",
+			},
+			"field_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the custom field the context belongs to (for example `jira_field.example.id`). Changing this forces a new context.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the context.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the context.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"project_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					requiresReplaceIfScopeToggled("project_ids"),
+				},
+				MarkdownDescription: "IDs of the projects the context applies to. When omitted, the context is global. Adding or removing the attribute forces a new context; changing its members does not.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")),
+				},
+			},
+			"work_type_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					requiresReplaceIfScopeToggled("work_type_ids"),
+				},
+				MarkdownDescription: "IDs of the work types the context applies to. When omitted, the context applies to any work type. Adding or removing the attribute forces a new context; changing its members does not.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric work type ID")),
+				},
+			},
+			"default_value": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Default value of the field in this context, for `textfield`, `textarea`, `url` and `float` fields. Defaults of option-based fields are set with `default` on `jira_field_context_options`.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+// toIntIDs converts numeric string IDs into the int IDs go-atlassian expects.
+func toIntIDs(ids []string, kind string) ([]int, error) {
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s id %q: %w", kind, id, err)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *fieldContextResource) createContext(ctx context.Context, p *fieldContextPayload) (*fieldContextAPIModel, *models.ResponseScheme, error) {
+	projectIDs, err := toIntIDs(p.ProjectIDs, "project")
+	if err != nil {
+		return nil, nil, err
+	}
+	workTypeIDs, err := toIntIDs(p.WorkTypeIDs, "work type")
+	if err != nil {
+		return nil, nil, err
+	}
+	created, rs, err := r.contextService.Create(ctx, p.FieldID, &models.FieldContextPayloadScheme{
+		Name:         p.Name,
+		Description:  p.Description,
+		ProjectIDs:   projectIDs,
+		IssueTypeIDs: workTypeIDs,
+	})
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	key := p.FieldID + "/" + created.ID
+	if p.DefaultValue != nil {
+		if rs, err := r.setDefaultValue(ctx, p.FieldID, created.ID, p.DefaultValue); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getContext(ctx, key)
+}
+
+// getContext reads the context, the projects and work types it applies to and its scalar default value. The
+// context search filters out global contexts once a context ID is passed, so the field's contexts are listed
+// instead, and a missing context is reported as a synthetic 404.
+func (r *fieldContextResource) getContext(ctx context.Context, key string) (*fieldContextAPIModel, *models.ResponseScheme, error) {
+	fieldID, contextID, err := parseFieldContextKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	id := strconv.Itoa(contextID)
+
+	var api *fieldContextAPIModel
+	var rs *models.ResponseScheme
+	for startAt := 0; api == nil; startAt += fieldContextPageSize {
+		page, pageRS, err := r.contextService.Gets(ctx, fieldID, nil, startAt, fieldContextPageSize)
+		if err != nil {
+			return nil, pageRS, err
+		}
+		rs = pageRS
+		for _, c := range page.Values {
+			if c != nil && c.ID == id {
+				api = &fieldContextAPIModel{FieldContextScheme: c, FieldID: fieldID}
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	if api == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("field context %s not found", key)
+	}
+
+	for startAt := 0; ; startAt += fieldContextPageSize {
+		page, pageRS, err := r.contextService.IssueTypesContext(ctx, fieldID, []int{contextID}, startAt, fieldContextPageSize)
+		if err != nil {
+			return nil, pageRS, err
+		}
+		for _, v := range page.Values {
+			if v != nil && v.ContextID == id && !v.IsAnyIssueType && v.IssueTypeID != "" {
+				api.WorkTypeIDs = append(api.WorkTypeIDs, v.IssueTypeID)
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	for startAt := 0; ; startAt += fieldContextPageSize {
+		page, pageRS, err := r.contextService.ProjectsContext(ctx, fieldID, []int{contextID}, startAt, fieldContextPageSize)
+		if err != nil {
+			return nil, pageRS, err
+		}
+		for _, v := range page.Values {
+			if v != nil && v.ContextID == id && !v.IsGlobalContext && v.ProjectID != "" {
+				api.ProjectIDs = append(api.ProjectIDs, v.ProjectID)
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	api.DefaultValue, rs, err = r.getDefaultValue(ctx, fieldID, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	return api, rs, nil
+}
+
+// fieldContextDefaultValue decodes a context default value. go-atlassian's model only carries option defaults, so
+// the scalar properties are decoded here.
+type fieldContextDefaultValue struct {
+	ContextID string   `json:"contextId"`
+	Type      string   `json:"type"`
+	Text      *string  `json:"text,omitempty"`
+	URL       *string  `json:"url,omitempty"`
+	Number    *float64 `json:"number,omitempty"`
+}
+
+// getDefaultValue returns the scalar default value of the context, or nil when it has none or an option default.
+func (r *fieldContextResource) getDefaultValue(ctx context.Context, fieldID, contextID string) (*string, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("rest/api/3/field/%s/context/defaultValue?%s", fieldID, url.Values{"contextId": {contextID}}.Encode())
+	var page struct {
+		Values []fieldContextDefaultValue `json:"values"`
+	}
+	rs, err := callJira(ctx, r.client, http.MethodGet, endpoint, nil, &page)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, v := range page.Values {
+		if v.ContextID != contextID {
+			continue
+		}
+		switch {
+		case v.Text != nil:
+			return v.Text, rs, nil
+		case v.URL != nil:
+			return v.URL, rs, nil
+		case v.Number != nil:
+			s := strconv.FormatFloat(*v.Number, 'f', -1, 64)
+			return &s, rs, nil
+		}
+	}
+	return nil, rs, nil
+}
+
+// setDefaultValue sets, or with a nil value clears, the scalar default value of the context. The default value type
+// depends on the field type, so the field is looked up first.
+func (r *fieldContextResource) setDefaultValue(ctx context.Context, fieldID, contextID string, value *string) (*models.ResponseScheme, error) {
+	fieldType, rs, err := lookupFieldType(ctx, r.client, fieldID)
+	if err != nil {
+		return rs, err
+	}
+	spec, ok := fieldScalarDefaultTypes[fieldType]
+	if !ok {
+		return nil, fmt.Errorf("default_value is not supported for %q fields; set defaults of option-based fields with jira_field_context_options", fieldType)
+	}
+	defaultValue := map[string]any{"contextId": contextID, "type": spec.Type, spec.Property: nil}
+	if value != nil {
+		defaultValue[spec.Property] = *value
+		if spec.Property == "number" {
+			n, err := strconv.ParseFloat(*value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("default_value %q is not a number: %w", *value, err)
+			}
+			defaultValue[spec.Property] = n
+		}
+	}
+	return putFieldContextDefault(ctx, r.client, fieldID, defaultValue)
+}
+
+// updateContext updates the metadata, then reconciles work types, projects and the default value against Jira.
+func (r *fieldContextResource) updateContext(ctx context.Context, key string, p *fieldContextPayload) (*fieldContextAPIModel, *models.ResponseScheme, error) {
+	fieldID, contextID, err := parseFieldContextKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, rs, err := r.getContext(ctx, key)
+	if err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.contextService.Update(ctx, fieldID, contextID, p.Name, p.Description); err != nil {
+		return nil, rs, err
+	}
+
+	added, removed := diffStrings(current.WorkTypeIDs, p.WorkTypeIDs)
+	if len(added) > 0 {
+		if rs, err := r.contextService.AddIssueTypes(ctx, fieldID, contextID, added); err != nil {
+			return nil, rs, err
+		}
+	}
+	if len(removed) > 0 {
+		if rs, err := r.contextService.RemoveIssueTypes(ctx, fieldID, contextID, removed); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	added, removed = diffStrings(current.ProjectIDs, p.ProjectIDs)
+	if len(added) > 0 {
+		if rs, err := r.contextService.Link(ctx, fieldID, contextID, added); err != nil {
+			return nil, rs, err
+		}
+	}
+	if len(removed) > 0 {
+		if rs, err := r.contextService.UnLink(ctx, fieldID, contextID, removed); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	if !equalStringPtr(current.DefaultValue, p.DefaultValue) {
+		if rs, err := r.setDefaultValue(ctx, fieldID, current.ID, p.DefaultValue); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getContext(ctx, key)
+}
+
+func (r *fieldContextResource) deleteContext(ctx context.Context, key string) (*models.ResponseScheme, error) {
+	fieldID, contextID, err := parseFieldContextKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return r.contextService.Delete(ctx, fieldID, contextID)
+}
+
+// diffStrings returns the elements of want missing from have, and the elements of have missing from want.
+func diffStrings(have, want []string) (added, removed []string) {
+	for _, v := range want {
+		if !slices.Contains(have, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range have {
+		if !slices.Contains(want, v) {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// equalStringPtr reports whether two optional strings are both unset or hold the same value.
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *fieldContextResource) hooks() CRUDHooks[fieldContextResourceModel, *fieldContextPayload, *fieldContextAPIModel] {
+	return CRUDHooks[fieldContextResourceModel, *fieldContextPayload, *fieldContextAPIModel]{
+		BuildPayload: func(ctx context.Context, st *fieldContextResourceModel) (*fieldContextPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			p := &fieldContextPayload{
+				FieldID:     st.FieldID.ValueString(),
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}
+			if !st.ProjectIDs.IsNull() && !st.ProjectIDs.IsUnknown() {
+				diags.Append(st.ProjectIDs.ElementsAs(ctx, &p.ProjectIDs, false)...)
+			}
+			if !st.WorkTypeIDs.IsNull() && !st.WorkTypeIDs.IsUnknown() {
+				diags.Append(st.WorkTypeIDs.ElementsAs(ctx, &p.WorkTypeIDs, false)...)
+			}
+			if !st.DefaultValue.IsNull() && !st.DefaultValue.IsUnknown() {
+				v := st.DefaultValue.ValueString()
+				p.DefaultValue = &v
+			}
+			return p, diags
+		},
+		APICreate:               r.createContext,
+		APIRead:                 r.getContext,
+		APIUpdate:               r.updateContext,
+		APIDelete:               r.deleteContext,
+		ExtractID:               func(st *fieldContextResourceModel) string { return st.fieldContextKey() },
+		MapToState:              mapFieldContextToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *fieldContextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *fieldContextResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldContextResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *fieldContextResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldContextResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *fieldContextResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *fieldContextResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *fieldContextResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fieldContextResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *fieldContextResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFieldContextResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_field_context.test"
+	name := acctest.RandomWithPrefix(accPrefixFieldContext)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetFieldContextCfg(t, testhelpers.FieldContextTmplCfg{
+					Name:         name,
+					FieldType:    "textfield",
+					WorkTypeName: workTypeName,
+					DefaultValue: "initial",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("default_value"), knownvalue.StringExact("initial")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("project_ids"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_ids"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetFieldContextCfg(t, testhelpers.FieldContextTmplCfg{
+					Name:            name,
+					Description:     "Updated field context description",
+					FieldType:       "textfield",
+					WorkTypeName:    workTypeName,
+					ScopeToWorkType: true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated field context description")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("default_value"), knownvalue.Null()),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("work_type_ids").AtSliceIndex(0), "jira_work_type.test", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
+
+func TestAccFieldContextOptionsResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_field_context_options.test"
+	name := acctest.RandomWithPrefix(accPrefixFieldContext)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+	alphaID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetFieldContextCfg(t, testhelpers.FieldContextTmplCfg{
+					Name:         name,
+					FieldType:    "cascadingselect",
+					WorkTypeName: workTypeName,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(0).AtMapKey("value"), knownvalue.StringExact("Alpha")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(0).AtMapKey("default"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(0).AtMapKey("children").AtSliceIndex(0).AtMapKey("default"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("child_option_ids").AtMapKey("Alpha"), knownvalue.MapSizeExact(2)),
+					alphaID.AddStateValue(rName, tfjsonpath.New("option_ids").AtMapKey("Alpha")),
+				},
+			},
+			{
+				// Reordering must keep option IDs, so work items keep their values.
+				Config: testhelpers.GetFieldContextCfg(t, testhelpers.FieldContextTmplCfg{
+					Name:         name,
+					FieldType:    "cascadingselect",
+					WorkTypeName: workTypeName,
+					Reverse:      true,
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(rName, tfjsonpath.New("child_option_ids").AtMapKey("Alpha"), knownvalue.MapSizeExact(2)),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(0).AtMapKey("value"), knownvalue.StringExact("Beta")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(0).AtMapKey("default"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(1).AtMapKey("disabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("options").AtSliceIndex(1).AtMapKey("children").AtSliceIndex(0).AtMapKey("value"), knownvalue.StringExact("Alpha two")),
+					alphaID.AddStateValue(rName, tfjsonpath.New("option_ids").AtMapKey("Alpha")),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default value types used by the custom field context default value endpoints.
+const (
+	fieldDefaultTypeOptionSingle    = "option.single"
+	fieldDefaultTypeOptionMultiple  = "option.multiple"
+	fieldDefaultTypeOptionCascading = "option.cascading"
+)
+
+// fieldScalarDefaultTypes maps the field types whose context default is a single scalar value to the default value
+// type and the JSON property Jira carries the value in.
+var fieldScalarDefaultTypes = map[string]struct{ Type, Property string }{
+	"textfield": {Type: "textfield", Property: "text"},
+	"textarea":  {Type: "textarea", Property: "text"},
+	"url":       {Type: "url", Property: "url"},
+	"float":     {Type: "float", Property: "number"},
+}
+
+// fieldOptionDefaultTypes maps the option-based field types to the default value type used for their options.
+var fieldOptionDefaultTypes = map[string]string{
+	"select":          fieldDefaultTypeOptionSingle,
+	"radiobuttons":    fieldDefaultTypeOptionSingle,
+	"multiselect":     fieldDefaultTypeOptionMultiple,
+	"multicheckboxes": fieldDefaultTypeOptionMultiple,
+	"cascadingselect": fieldDefaultTypeOptionCascading,
+}
+
+// fieldContextResourceModel models the Terraform schema/state for jira_field_context.
+type fieldContextResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	FieldID      types.String `tfsdk:"field_id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	ProjectIDs   types.Set    `tfsdk:"project_ids"`
+	WorkTypeIDs  types.Set    `tfsdk:"work_type_ids"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+func (m *fieldContextResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"field_id":      types.StringType,
+		"name":          types.StringType,
+		"description":   types.StringType,
+		"project_ids":   types.SetType{ElemType: types.StringType},
+		"work_type_ids": types.SetType{ElemType: types.StringType},
+		"default_value": types.StringType,
+	}
+}
+
+// fieldContextKey returns the "field_id/context_id" key the context endpoints are addressed by; it is also the import ID.
+func (m *fieldContextResourceModel) fieldContextKey() string {
+	return m.FieldID.ValueString() + "/" + m.ID.ValueString()
+}
+
+// parseFieldContextKey splits a "field_id/context_id" key into the field ID and the numeric context ID.
+func parseFieldContextKey(key string) (fieldID string, contextID int, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", 0, fmt.Errorf("invalid field context id %q: expected format field_id/context_id", key)
+	}
+	if contextID, err = strconv.Atoi(parts[1]); err != nil {
+		return "", 0, fmt.Errorf("invalid field context id %q: %w", parts[1], err)
+	}
+	return parts[0], contextID, nil
+}
+
+// fieldContextPayload carries the planned context for create/update. go-atlassian splits a context across
+// several endpoints, so the provider defines its own payload. ProjectIDs and WorkTypeIDs are nil for a global
+// context and one that applies to any work type; DefaultValue is nil when no default is configured.
+type fieldContextPayload struct {
+	FieldID      string
+	Name         string
+	Description  string
+	ProjectIDs   []string
+	WorkTypeIDs  []string
+	DefaultValue *string
+}
+
+// fieldContextAPIModel wraps the go-atlassian context model with its field, the projects and work types it
+// applies to and its scalar default value, which Jira serves from separate endpoints.
+type fieldContextAPIModel struct {
+	*models.FieldContextScheme
+	FieldID      string
+	ProjectIDs   []string
+	WorkTypeIDs  []string
+	DefaultValue *string
+}
+
+// mapFieldContextToModel centralizes mapping for the field context resource and matches CRUDHooks MapToState signature.
+func mapFieldContextToModel(ctx context.Context, api *fieldContextAPIModel, st *fieldContextResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.FieldContextScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no field context payload to map into state.")
+		return diags
+	}
+	projectIDs, d := stringSetOrNull(ctx, api.ProjectIDs)
+	diags.Append(d...)
+	workTypeIDs, d := stringSetOrNull(ctx, api.WorkTypeIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	defaultValue := types.StringNull()
+	if api.DefaultValue != nil {
+		defaultValue = types.StringValue(*api.DefaultValue)
+	}
+	*st = fieldContextResourceModel{
+		ID:           types.StringValue(api.ID),
+		FieldID:      types.StringValue(api.FieldID),
+		Name:         types.StringValue(api.Name),
+		Description:  stringOrNull(api.Description),
+		ProjectIDs:   projectIDs,
+		WorkTypeIDs:  workTypeIDs,
+		DefaultValue: defaultValue,
+	}
+	return diags
+}
+
+// fieldContextOptionsResourceModel models the Terraform schema/state for jira_field_context_options.
+type fieldContextOptionsResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	FieldID        types.String `tfsdk:"field_id"`
+	ContextID      types.String `tfsdk:"context_id"`
+	Options        types.List   `tfsdk:"options"`
+	OptionIDs      types.Map    `tfsdk:"option_ids"`
+	ChildOptionIDs types.Map    `tfsdk:"child_option_ids"`
+}
+
+// fieldContextOptionModel models a single element of the options list.
+type fieldContextOptionModel struct {
+	Value    types.String `tfsdk:"value"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Default  types.Bool   `tfsdk:"default"`
+	Children types.List   `tfsdk:"children"`
+}
+
+// fieldContextChildOptionModel models a single element of an option's children list.
+type fieldContextChildOptionModel struct {
+	Value    types.String `tfsdk:"value"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Default  types.Bool   `tfsdk:"default"`
+}
+
+func (m *fieldContextChildOptionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"value":    types.StringType,
+		"disabled": types.BoolType,
+		"default":  types.BoolType,
+	}
+}
+
+func (m *fieldContextOptionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"value":    types.StringType,
+		"disabled": types.BoolType,
+		"default":  types.BoolType,
+		"children": types.ListType{ElemType: types.ObjectType{AttrTypes: (&fieldContextChildOptionModel{}).AttributeTypes()}},
+	}
+}
+
+func (m *fieldContextOptionsResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":               types.StringType,
+		"field_id":         types.StringType,
+		"context_id":       types.StringType,
+		"options":          types.ListType{ElemType: types.ObjectType{AttrTypes: (&fieldContextOptionModel{}).AttributeTypes()}},
+		"option_ids":       types.MapType{ElemType: types.StringType},
+		"child_option_ids": types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	}
+}
+
+// fieldOption is a provider-side option used by both the payload and the API model. ID is empty for options
+// that do not exist yet; Children is only populated for top-level options of cascading fields.
+type fieldOption struct {
+	ID       string
+	Value    string
+	Disabled bool
+	Default  bool
+	Children []*fieldOption
+}
+
+// fieldContextOptionsPayload carries the planned, ordered options of a context.
+type fieldContextOptionsPayload struct {
+	FieldID   string
+	ContextID int
+	Options   []*fieldOption
+}
+
+// fieldContextOptionsAPIModel holds the options of a context in display order, with their default flags
+// resolved from the context's default value.
+type fieldContextOptionsAPIModel struct {
+	FieldID   string
+	ContextID int
+	Options   []*fieldOption
+}
+
+// mapFieldContextOptionsToModel centralizes mapping for the field context options resource and matches CRUDHooks MapToState signature.
+func mapFieldContextOptionsToModel(ctx context.Context, api *fieldContextOptionsAPIModel, st *fieldContextOptionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no field context options payload to map into state.")
+		return diags
+	}
+
+	childElemType := types.ObjectType{AttrTypes: (&fieldContextChildOptionModel{}).AttributeTypes()}
+	optionIDs := make(map[string]string, len(api.Options))
+	childOptionIDs := map[string]map[string]string{}
+	options := make([]fieldContextOptionModel, 0, len(api.Options))
+	for _, o := range api.Options {
+		optionIDs[o.Value] = o.ID
+		m := fieldContextOptionModel{
+			Value:    types.StringValue(o.Value),
+			Disabled: types.BoolValue(o.Disabled),
+			Default:  types.BoolValue(o.Default),
+			Children: types.ListNull(childElemType),
+		}
+		if len(o.Children) > 0 {
+			children := make([]fieldContextChildOptionModel, 0, len(o.Children))
+			childOptionIDs[o.Value] = make(map[string]string, len(o.Children))
+			for _, c := range o.Children {
+				childOptionIDs[o.Value][c.Value] = c.ID
+				children = append(children, fieldContextChildOptionModel{
+					Value:    types.StringValue(c.Value),
+					Disabled: types.BoolValue(c.Disabled),
+					Default:  types.BoolValue(c.Default),
+				})
+			}
+			var d diag.Diagnostics
+			m.Children, d = types.ListValueFrom(ctx, childElemType, children)
+			diags.Append(d...)
+		}
+		options = append(options, m)
+	}
+
+	optionList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&fieldContextOptionModel{}).AttributeTypes()}, options)
+	diags.Append(d...)
+	optionIDMap, d := types.MapValueFrom(ctx, types.StringType, optionIDs)
+	diags.Append(d...)
+	childOptionIDMap, d := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, childOptionIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	contextID := strconv.Itoa(api.ContextID)
+	*st = fieldContextOptionsResourceModel{
+		ID:             types.StringValue(api.FieldID + "/" + contextID),
+		FieldID:        types.StringValue(api.FieldID),
+		ContextID:      types.StringValue(contextID),
+		Options:        optionList,
+		OptionIDs:      optionIDMap,
+		ChildOptionIDs: childOptionIDMap,
+	}
+	return diags
+}
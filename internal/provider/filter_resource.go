@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*filterResource)(nil)
+var _ resource.ResourceWithConfigure = (*filterResource)(nil)
+var _ resource.ResourceWithImportState = (*filterResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*filterResource)(nil)
+
+// NewFilterResource returns the Terraform resource implementation for jira_filter.
+func NewFilterResource() resource.Resource { return &filterResource{} }
+
+type filterResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[filterResourceModel, *filterPayload, *filterAPIModel]
+}
+
+func (r *filterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter"
+}
+
+func (r *filterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *filterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a saved Jira filter, a named JQL query that can back dashboards and boards. The filter is owned by the provider's user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the filter. Automatically generated by Jira when the filter is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the filter. Must be unique among the filters of the owner.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the filter.",
+			},
+			"jql": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The JQL query of the filter. When the provider is configured, the query is checked with Jira's JQL " +
+					"parser at plan time.",
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"favourite": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the filter is a favourite of the provider's user. Defaults to `false`.",
+			},
+			"share_permissions": sharePermissionsAttribute("Who can view the filter. When omitted, the filter is private to its owner."),
+			"edit_permissions":  sharePermissionsAttribute("Who can edit the filter, in addition to its owner."),
+		},
+	}
+}
+
+// ValidateConfig checks that each permission has the IDs its type needs and, once the provider is configured, that
+// Jira can parse the JQL, so a broken query surfaces at plan time instead of at apply.
+func (r *filterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg filterResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateSharePermissions(ctx, cfg.SharePermissions, path.Root("share_permissions"), &resp.Diagnostics)
+	validateSharePermissions(ctx, cfg.EditPermissions, path.Root("edit_permissions"), &resp.Diagnostics)
+
+	// The provider is not configured during `terraform validate`; the query is checked again at plan time.
+	if r.client == nil || cfg.JQL.IsNull() || cfg.JQL.IsUnknown() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	// The check is best effort: a failed parse request is logged and skipped, and Jira rejects bad JQL on apply.
+	page, rs, err := r.client.JQL.Parse(ctx, "strict", []string{cfg.JQL.ValueString()})
+	if err != nil || page == nil || !IsSuccess(HTTPStatusFromScheme(rs)) {
+		tflog.Debug(ctx, "skipping JQL check at plan time", map[string]interface{}{"status": HTTPStatusFromScheme(rs)})
+		return
+	}
+	for _, q := range page.Queries {
+		if q != nil && len(q.Errors) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("jql"), "Invalid JQL", strings.Join(q.Errors, "\n"))
+		}
+	}
+}
+
+// Wrapper functions to adapt the filter endpoints. Requests are sent directly (see filterPayload).
+func (r *filterResource) createFilter(ctx context.Context, p *filterPayload) (*filterAPIModel, *models.ResponseScheme, error) {
+	body := *p
+	body.EditPermissions = nil
+	var created filterAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/filter", &body, &created); err != nil {
+		return nil, rs, err
+	}
+	// Jira only accepts edit permissions on an existing filter.
+	if p.EditPermissions != nil && len(*p.EditPermissions) > 0 {
+		if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/filter/%s", created.ID), p, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getFilter(ctx, created.ID)
+}
+
+func (r *filterResource) getFilter(ctx context.Context, id string) (*filterAPIModel, *models.ResponseScheme, error) {
+	var filter filterAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/filter/%s", id), nil, &filter)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &filter, rs, nil
+}
+
+// updateFilter updates the filter, then marks or unmarks it as a favourite, which the update itself does not change.
+func (r *filterResource) updateFilter(ctx context.Context, id string, p *filterPayload) (*filterAPIModel, *models.ResponseScheme, error) {
+	var updated filterAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/filter/%s", id), p, &updated); err != nil {
+		return nil, rs, err
+	}
+	if updated.Favourite != p.Favourite {
+		method := http.MethodPut
+		if !p.Favourite {
+			method = http.MethodDelete
+		}
+		if rs, err := callJira(ctx, r.client, method, fmt.Sprintf("rest/api/3/filter/%s/favourite", id), nil, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getFilter(ctx, id)
+}
+
+func (r *filterResource) deleteFilter(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/filter/%s", id), nil, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *filterResource) hooks() CRUDHooks[filterResourceModel, *filterPayload, *filterAPIModel] {
+	return CRUDHooks[filterResourceModel, *filterPayload, *filterAPIModel]{
+		BuildPayload: func(ctx context.Context, st *filterResourceModel) (*filterPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			edit := sharePermissionsFromSet(ctx, st.EditPermissions, &diags)
+			return &filterPayload{
+				Name:             st.Name.ValueString(),
+				Description:      st.Description.ValueString(),
+				JQL:              st.JQL.ValueString(),
+				Favourite:        st.Favourite.ValueBool(),
+				SharePermissions: sharePermissionsFromSet(ctx, st.SharePermissions, &diags),
+				EditPermissions:  &edit,
+			}, diags
+		},
+		APICreate:               r.createFilter,
+		APIRead:                 r.getFilter,
+		APIUpdate:               r.updateFilter,
+		APIDelete:               r.deleteFilter,
+		ExtractID:               func(st *filterResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapFilterToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *filterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *filterResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *filterResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *filterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *filterResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *filterResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *filterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *filterResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *filterResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *filterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *filterResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *filterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *filterResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFilterResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_filter.test"
+	key := randomProjectKey(6)
+	name := strings.ReplaceAll(acctest.RandomWithPrefix(accPrefixFilter), "_", "-")
+	leadAccountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetFilterCfg(t, testhelpers.FilterTmplCfg{ProjectKey: key, Name: name, LeadAccountID: leadAccountID}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("jql"), knownvalue.StringExact("project = "+key+" ORDER BY created DESC")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("favourite"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("share_permissions"), knownvalue.SetSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("edit_permissions"), knownvalue.SetSizeExact(1)),
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config: testhelpers.GetFilterCfg(t, testhelpers.FilterTmplCfg{
+					ProjectKey:    key,
+					Name:          name,
+					LeadAccountID: leadAccountID,
+					Description:   "Unresolved work of the project",
+					Favourite:     true,
+					Updated:       true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Unresolved work of the project")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("favourite"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("share_permissions"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"type":     knownvalue.StringExact(sharePermissionTypeProjectRole),
+							"group_id": knownvalue.Null(),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("edit_permissions"), knownvalue.Null()),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAccFilterResource_invalidJQL(t *testing.T) {
+	t.Parallel()
+
+	// Jira's JQL parser rejects the query at plan time, and the error is attached to jql.
+	cfg := `
+resource "jira_filter" "test" {
+  name = "tf-acc-filter-invalid-jql"
+  jql  = "project = = ORDER BY"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid JQL`),
+			},
+		},
+	})
+}
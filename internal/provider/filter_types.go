@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// filterResourceModel models the Terraform schema/state for jira_filter.
+type filterResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	JQL              types.String `tfsdk:"jql"`
+	Favourite        types.Bool   `tfsdk:"favourite"`
+	SharePermissions types.Set    `tfsdk:"share_permissions"`
+	EditPermissions  types.Set    `tfsdk:"edit_permissions"`
+}
+
+// filterPayload carries the planned filter for create/update. go-atlassian omits empty properties and has no edit
+// permissions on its filter type, so the provider defines its own request and response types. Share permissions are
+// always sent so that an empty list removes them; edit permissions cannot be set when creating a filter, so a nil
+// EditPermissions leaves them out of the request.
+type filterPayload struct {
+	Name             string                      `json:"name"`
+	Description      string                      `json:"description"`
+	JQL              string                      `json:"jql"`
+	Favourite        bool                        `json:"favourite"`
+	SharePermissions []*sharePermissionAPIModel  `json:"sharePermissions"`
+	EditPermissions  *[]*sharePermissionAPIModel `json:"editPermissions,omitempty"`
+}
+
+// filterAPIModel is the filter as returned by Jira.
+type filterAPIModel struct {
+	ID               string                     `json:"id"`
+	Name             string                     `json:"name"`
+	Description      string                     `json:"description"`
+	JQL              string                     `json:"jql"`
+	Favourite        bool                       `json:"favourite"`
+	SharePermissions []*sharePermissionAPIModel `json:"sharePermissions"`
+	EditPermissions  []*sharePermissionAPIModel `json:"editPermissions"`
+}
+
+// mapFilterToModel centralizes mapping for the filter resource and matches CRUDHooks MapToState signature.
+func mapFilterToModel(ctx context.Context, api *filterAPIModel, st *filterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no filter payload to map into state.")
+		return diags
+	}
+	share, d := sharePermissionSet(ctx, api.SharePermissions)
+	diags.Append(d...)
+	edit, d := sharePermissionSet(ctx, api.EditPermissions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	*st = filterResourceModel{
+		ID:               types.StringValue(api.ID),
+		Name:             types.StringValue(api.Name),
+		Description:      stringOrNull(api.Description),
+		JQL:              types.StringValue(api.JQL),
+		Favourite:        types.BoolValue(api.Favourite),
+		SharePermissions: share,
+		EditPermissions:  edit,
+	}
+	return diags
+}
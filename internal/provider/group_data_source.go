@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ datasource.DataSource = (*groupDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*groupDataSource)(nil)
+
+// NewGroupDataSource returns the Terraform data source implementation for jira_group (lookup by ID or name).
+func NewGroupDataSource() datasource.DataSource { return &groupDataSource{} }
+
+type groupDataSource struct {
+	ServiceClient
+	groupService jira.GroupConnector
+}
+
+func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a single Jira group by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The group ID. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The group name. Exactly one of id or name must be set.",
+			},
+		},
+	}
+}
+
+func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.groupService = provider.client.Group
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data groupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idSet := !data.ID.IsNull() && data.ID.ValueString() != ""
+	nameSet := !data.Name.IsNull() && data.Name.ValueString() != ""
+	if idSet == nameSet {
+		resp.Diagnostics.AddError(
+			"Invalid configuration for jira_group data source",
+			"Exactly one of 'id' or 'name' must be set to lookup a group.",
+		)
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Exactly one of id or name required", "Set either 'id' or 'name', but not both.")
+		return
+	}
+
+	opts := &models.GroupBulkOptionsScheme{GroupIDs: []string{data.ID.ValueString()}}
+	lookup := fmt.Sprintf("ID %q", data.ID.ValueString())
+	if nameSet {
+		opts = &models.GroupBulkOptionsScheme{GroupNames: []string{data.Name.ValueString()}}
+		lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+	}
+	page, apiResp, err := d.groupService.Bulk(ctx, opts, 0, 1)
+	if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "get group", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+	if len(page.Values) == 0 || page.Values[0] == nil {
+		resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No Jira group with %s exists.", lookup))
+		return
+	}
+
+	resp.Diagnostics.Append(mapGroupToModel(ctx, page.Values[0], &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix(accPrefixGroup)
+	groupCfg := testhelpers.GetGroupCfg(t, testhelpers.GroupTmplCfg{Name: name, AccountID: testhelpers.GetTestProjLeadAcctIdFromEnv()})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: groupCfg + `
+data "jira_group" "by_name" {
+  name = jira_group.test.name
+}
+
+data "jira_group" "by_id" {
+  id = jira_group.test.id
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("data.jira_group.by_name", tfjsonpath.New("id"), "jira_group.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("data.jira_group.by_id", tfjsonpath.New("name"), knownvalue.StringExact(name)),
+				},
+			},
+			{
+				Config:      fmt.Sprintf(`data "jira_group" "missing" { name = %q }`, name+"-missing"),
+				ExpectError: regexp.MustCompile(`Group not found`),
+			},
+		},
+	})
+}
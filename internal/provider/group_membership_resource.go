@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*groupMembershipResource)(nil)
+var _ resource.ResourceWithConfigure = (*groupMembershipResource)(nil)
+var _ resource.ResourceWithImportState = (*groupMembershipResource)(nil)
+
+// NewGroupMembershipResource returns the Terraform resource implementation for jira_group_membership.
+func NewGroupMembershipResource() resource.Resource { return &groupMembershipResource{} }
+
+type groupMembershipResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[groupMembershipResourceModel, *groupMembershipPayload, *groupMembershipAPIModel]
+}
+
+func (r *groupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *groupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *groupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the users of a Jira group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The identifier of the resource; the same as `group_id`.",
+			},
+			"group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the group (for example `jira_group.example.id`). Changing this forces a new resource.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"mode": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(membershipModeAdditive),
+				Validators: []validator.String{stringvalidator.OneOf(membershipModeAdditive, membershipModeAuthoritative)},
+				MarkdownDescription: "How membership is managed. With `additive` (the default) only the listed users are managed, so users added in Jira are kept and never reported as drift. " +
+					"With `authoritative` the listed users are the only members of the group, so any other user is removed.",
+			},
+			"users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Account IDs of the users in the group.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the group member endpoints. go-atlassian addresses groups by name, so the requests
+// are sent directly to address the group by ID.
+func (r *groupMembershipResource) createMembers(ctx context.Context, p *groupMembershipPayload) (*groupMembershipAPIModel, *models.ResponseScheme, error) {
+	return r.updateMembers(ctx, p.GroupID, p)
+}
+
+// getMembers reads the account IDs of every member of the group, including inactive users.
+func (r *groupMembershipResource) getMembers(ctx context.Context, groupID string) (*groupMembershipAPIModel, *models.ResponseScheme, error) {
+	var (
+		members []*models.GroupUserDetailScheme
+		rs      *models.ResponseScheme
+	)
+	for startAt := 0; ; {
+		params := url.Values{"groupId": {groupID}, "includeInactiveUsers": {"true"}, "startAt": {fmt.Sprint(startAt)}, "maxResults": {"50"}}
+		var page models.GroupMemberPageScheme
+		var err error
+		rs, err = callJira(ctx, r.client, http.MethodGet, "rest/api/3/group/member?"+params.Encode(), nil, &page)
+		if err != nil {
+			return nil, rs, err
+		}
+		members = append(members, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return newGroupMembershipAPIModel(groupID, members), rs, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// membership adapts the group member endpoints for the shared additive/authoritative reconciliation.
+func (r *groupMembershipResource) membership(groupID string) membershipReconciler {
+	return membershipReconciler{
+		list: func(ctx context.Context) (membersByKind, *models.ResponseScheme, error) {
+			current, rs, err := r.getMembers(ctx, groupID)
+			if err != nil {
+				return nil, rs, err
+			}
+			return membersByKind{memberKindUsers: current.Users}, rs, nil
+		},
+		add: func(ctx context.Context, members membersByKind) (*models.ResponseScheme, error) {
+			endpoint := "rest/api/3/group/user?" + url.Values{"groupId": {groupID}}.Encode()
+			for _, accountID := range members[memberKindUsers] {
+				if rs, err := callJira(ctx, r.client, http.MethodPost, endpoint, map[string]string{"accountId": accountID}, nil); err != nil {
+					return rs, err
+				}
+			}
+			return &models.ResponseScheme{Code: http.StatusCreated}, nil
+		},
+		remove: func(ctx context.Context, _, accountID string) (*models.ResponseScheme, error) {
+			endpoint := "rest/api/3/group/user?" + url.Values{"groupId": {groupID}, "accountId": {accountID}}.Encode()
+			return callJira(ctx, r.client, http.MethodDelete, endpoint, nil, nil)
+		},
+	}
+}
+
+// updateMembers reconciles the group members with the payload and reads them back.
+func (r *groupMembershipResource) updateMembers(ctx context.Context, groupID string, p *groupMembershipPayload) (*groupMembershipAPIModel, *models.ResponseScheme, error) {
+	if rs, err := r.membership(groupID).update(ctx, membersByKind{memberKindUsers: p.Users}, p.Mode); err != nil {
+		return nil, rs, err
+	}
+	return r.getMembers(ctx, groupID)
+}
+
+// removeManaged removes the users of prior that are not in plan; with a nil plan it removes all of them.
+func (r *groupMembershipResource) removeManaged(ctx context.Context, prior, plan *groupMembershipResourceModel) (*models.ResponseScheme, error) {
+	priorMembers, err := prior.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var planMembers membersByKind
+	if plan != nil {
+		if planMembers, err = plan.members(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return r.membership(prior.GroupID.ValueString()).removeManaged(ctx, priorMembers, planMembers)
+}
+
+// managedMembers narrows the members read from Jira to the users in state when membership is additive.
+func managedMembers(ctx context.Context, api *groupMembershipAPIModel, st *groupMembershipResourceModel) (*groupMembershipAPIModel, *models.ResponseScheme, error) {
+	state, err := st.members(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	api.Users = narrowManaged(st.Mode.ValueString(), membersByKind{memberKindUsers: api.Users}, state)[memberKindUsers]
+	return api, &models.ResponseScheme{Code: http.StatusOK}, nil
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *groupMembershipResource) hooks() CRUDHooks[groupMembershipResourceModel, *groupMembershipPayload, *groupMembershipAPIModel] {
+	return CRUDHooks[groupMembershipResourceModel, *groupMembershipPayload, *groupMembershipAPIModel]{
+		BuildPayload: func(ctx context.Context, st *groupMembershipResourceModel) (*groupMembershipPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			return &groupMembershipPayload{
+				GroupID: st.GroupID.ValueString(),
+				Mode:    st.Mode.ValueString(),
+				Users:   setStrings(ctx, st.Users, &diags),
+			}, diags
+		},
+		APICreate:  r.createMembers,
+		APIRead:    r.getMembers,
+		APIUpdate:  r.updateMembers,
+		ExtractID:  func(st *groupMembershipResourceModel) string { return st.GroupID.ValueString() },
+		MapToState: mapGroupMembershipToModel,
+		PostCreate: managedMembers,
+		PostRead:   managedMembers,
+		PostUpdate: managedMembers,
+	}
+}
+
+func (r *groupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *groupMembershipResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *groupMembershipResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *groupMembershipResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *groupMembershipResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *groupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	var state, plan groupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if removesManagedOnUpdate(state.Mode.ValueString(), plan.Mode.ValueString()) {
+		rs, err := r.removeManaged(ctx, &state, &plan)
+		if !ensureWith(&resp.Diagnostics)(ctx, "remove group members", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+	}
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *groupMembershipResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *groupMembershipResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the users in state. The generic runner only passes the ID to its delete hook, while which users
+// to remove depends on the state.
+func (r *groupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	var state groupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rs, err := r.removeManaged(ctx, &state, nil)
+	ensureWith(&resp.Diagnostics)(ctx, "remove group members", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true})
+}
+
+func (r *groupMembershipResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *groupMembershipResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
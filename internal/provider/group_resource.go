@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*groupResource)(nil)
+var _ resource.ResourceWithConfigure = (*groupResource)(nil)
+var _ resource.ResourceWithImportState = (*groupResource)(nil)
+
+// NewGroupResource returns the Terraform resource implementation for jira_group.
+func NewGroupResource() resource.Resource { return &groupResource{} }
+
+type groupResource struct {
+	ServiceClient
+	groupService jira.GroupConnector
+	crudRunner   CRUDRunner[groupResourceModel, *models.GroupDetailScheme, *models.GroupDetailScheme]
+}
+
+func (r *groupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *groupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.groupService = provider.client.Group
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira group. Manage its members with `jira_group_membership`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The group ID. Automatically generated by Jira when the group is created and, unlike the name, never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The name of the group. Must be unique. Jira groups cannot be renamed through the API, so changing this forces a new group.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource. go-atlassian addresses groups by name, so
+// creates and deletes are sent directly to get and use the group ID.
+func (r *groupResource) createGroup(ctx context.Context, p *models.GroupDetailScheme) (*models.GroupDetailScheme, *models.ResponseScheme, error) {
+	var created models.GroupDetailScheme
+	rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/group", map[string]string{"name": p.Name}, &created)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &created, rs, nil
+}
+
+func (r *groupResource) getGroup(ctx context.Context, id string) (*models.GroupDetailScheme, *models.ResponseScheme, error) {
+	page, rs, err := r.groupService.Bulk(ctx, &models.GroupBulkOptionsScheme{GroupIDs: []string{id}}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, g := range page.Values {
+		if g != nil && g.GroupID == id {
+			return g, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("group %s not found", id)
+}
+
+func (r *groupResource) deleteGroup(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, "rest/api/3/group?"+url.Values{"groupId": {id}}.Encode(), nil, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *groupResource) hooks() CRUDHooks[groupResourceModel, *models.GroupDetailScheme, *models.GroupDetailScheme] {
+	return CRUDHooks[groupResourceModel, *models.GroupDetailScheme, *models.GroupDetailScheme]{
+		BuildPayload: func(_ context.Context, st *groupResourceModel) (*models.GroupDetailScheme, diag.Diagnostics) {
+			return &models.GroupDetailScheme{Name: st.Name.ValueString()}, nil
+		},
+		APICreate:               r.createGroup,
+		APIRead:                 r.getGroup,
+		APIDelete:               r.deleteGroup,
+		ExtractID:               func(st *groupResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapGroupToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *groupResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *groupResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *groupResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *groupResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never planned: the name forces a new group and the ID is computed.
+func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *groupResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *groupResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *groupResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupResource_basic(t *testing.T) {
+	t.Parallel()
+
+	groupName := "jira_group.test"
+	membershipName := "jira_group_membership.test"
+	name := acctest.RandomWithPrefix(accPrefixGroup)
+	accountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+	sameGroupID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetGroupCfg(t, testhelpers.GroupTmplCfg{Name: name, AccountID: accountID}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(groupName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					sameGroupID.AddStateValue(groupName, tfjsonpath.New("id")),
+					statecheck.CompareValuePairs(membershipName, tfjsonpath.New("group_id"), groupName, tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(membershipName, tfjsonpath.New("mode"), knownvalue.StringExact(membershipModeAdditive)),
+					statecheck.ExpectKnownValue(membershipName, tfjsonpath.New("users"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(accountID),
+					})),
+				},
+			},
+			{
+				Config: testhelpers.GetGroupCfg(t, testhelpers.GroupTmplCfg{Name: name, AccountID: accountID, Mode: membershipModeAuthoritative}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameGroupID.AddStateValue(groupName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(membershipName, tfjsonpath.New("mode"), knownvalue.StringExact(membershipModeAuthoritative)),
+					statecheck.ExpectKnownValue(membershipName, tfjsonpath.New("users"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(accountID),
+					})),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    groupName,
+			},
+			{
+				// An import starts additive with no managed users, so only the group ID is verified.
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mode", "users"},
+				ResourceName:            membershipName,
+			},
+		},
+	})
+}
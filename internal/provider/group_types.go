@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// groupResourceModel models the Terraform schema/state for jira_group. It is also the element type of the groups
+// map of the jira_groups data source.
+type groupResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (m *groupResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+}
+
+// mapGroupToModel centralizes mapping for the group resource and data sources and matches CRUDHooks MapToState signature.
+func mapGroupToModel(_ context.Context, api *models.GroupDetailScheme, st *groupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no group payload to map into state.")
+		return diags
+	}
+	*st = groupResourceModel{
+		ID:   types.StringValue(api.GroupID),
+		Name: types.StringValue(api.Name),
+	}
+	return diags
+}
+
+// groupMembershipResourceModel models the Terraform schema/state for jira_group_membership.
+type groupMembershipResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	GroupID types.String `tfsdk:"group_id"`
+	Mode    types.String `tfsdk:"mode"`
+	Users   types.Set    `tfsdk:"users"`
+}
+
+// members returns the members in the model by kind.
+func (m *groupMembershipResourceModel) members(ctx context.Context) (membersByKind, error) {
+	return membersFromState(ctx, map[string]types.Set{memberKindUsers: m.Users})
+}
+
+func (m *groupMembershipResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":       types.StringType,
+		"group_id": types.StringType,
+		"mode":     types.StringType,
+		"users":    types.SetType{ElemType: types.StringType},
+	}
+}
+
+// groupMembershipPayload carries the planned members for create/update.
+type groupMembershipPayload struct {
+	GroupID string
+	Mode    string
+	Users   []string
+}
+
+// groupMembershipAPIModel is the account IDs of the members of a group, sorted.
+type groupMembershipAPIModel struct {
+	GroupID string
+	Users   []string
+}
+
+// newGroupMembershipAPIModel collects the account IDs of the members of a group.
+func newGroupMembershipAPIModel(groupID string, members []*models.GroupUserDetailScheme) *groupMembershipAPIModel {
+	api := &groupMembershipAPIModel{GroupID: groupID}
+	for _, m := range members {
+		if m != nil && m.AccountID != "" {
+			api.Users = append(api.Users, m.AccountID)
+		}
+	}
+	sort.Strings(api.Users)
+	return api
+}
+
+// mapGroupMembershipToModel centralizes mapping for the group membership resource and matches CRUDHooks MapToState
+// signature. The mode is not stored in Jira, so it is carried over from st; an import starts additive.
+func mapGroupMembershipToModel(ctx context.Context, api *groupMembershipAPIModel, st *groupMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no group membership payload to map into state.")
+		return diags
+	}
+	mode := st.Mode
+	if mode.IsNull() || mode.IsUnknown() {
+		mode = types.StringValue(membershipModeAdditive)
+	}
+	users, d := stringSetOrNull(ctx, api.Users)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = groupMembershipResourceModel{
+		ID:      types.StringValue(api.GroupID),
+		GroupID: types.StringValue(api.GroupID),
+		Mode:    mode,
+		Users:   users,
+	}
+	return diags
+}
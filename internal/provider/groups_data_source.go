@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*groupsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*groupsDataSource)(nil)
+
+// NewGroupsDataSource returns the Terraform data source implementation for jira_groups (list/filter; pagination).
+func NewGroupsDataSource() datasource.DataSource { return &groupsDataSource{} }
+
+type groupsDataSource struct {
+	ServiceClient
+	groupService jira.GroupConnector
+}
+
+type groupsDataSourceModel struct {
+	// Optional filters
+	IDs   types.List `tfsdk:"ids"`
+	Names types.List `tfsdk:"names"`
+
+	// Outputs
+	Groups types.Map `tfsdk:"groups"`
+}
+
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List Jira groups, optionally filtered by IDs or names. Without filters every group of the site is returned.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter by group IDs.",
+			},
+			"names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter by group names.",
+			},
+			"groups": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of groups keyed by group name. Each value includes id and name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The group ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The group name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.groupService = provider.client.Group
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data groupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, deferIDs := getKnownStrings(ctx, data.IDs, "ids", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || deferIDs {
+		return
+	}
+	names, deferNames := getKnownStrings(ctx, data.Names, "names", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || deferNames {
+		return
+	}
+
+	opts := &models.GroupBulkOptionsScheme{GroupIDs: ids, GroupNames: names}
+	var runner CRUDRunner[groupResourceModel, *models.GroupDetailScheme, *models.GroupDetailScheme]
+	out, listDiags := runner.DoListGroups(ctx, ListHooks[*models.GroupDetailScheme, groupResourceModel]{
+		ListPage: func(ctx context.Context, startAt, max int) ([]*models.GroupDetailScheme, bool, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			page, apiResp, err := d.groupService.Bulk(ctx, opts, startAt, max)
+			if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "list groups", apiResp, err, &diags, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+				return nil, true, diags
+			}
+			return page.Values, page.IsLast, diags
+		},
+		Filter: func(_ context.Context, g *models.GroupDetailScheme) bool {
+			return g != nil
+		},
+		KeyOf: func(g *models.GroupDetailScheme) string {
+			return g.Name
+		},
+		MapToOut: func(ctx context.Context, g *models.GroupDetailScheme) (groupResourceModel, diag.Diagnostics) {
+			var m groupResourceModel
+			diags := mapGroupToModel(ctx, g, &m)
+			return m, diags
+		},
+		AttrTypes: (&groupResourceModel{}).AttributeTypes,
+	})
+	resp.Diagnostics.Append(listDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Groups, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: (&groupResourceModel{}).AttributeTypes()}, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupsDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix(accPrefixGroup)
+	groupCfg := testhelpers.GetGroupCfg(t, testhelpers.GroupTmplCfg{Name: name, AccountID: testhelpers.GetTestProjLeadAcctIdFromEnv()})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: groupCfg + `
+data "jira_groups" "filtered" {
+  names = [jira_group.test.name]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.jira_groups.filtered", tfjsonpath.New("groups"), knownvalue.MapSizeExact(1)),
+					statecheck.CompareValuePairs(
+						"data.jira_groups.filtered", tfjsonpath.New("groups").AtMapKey(name).AtMapKey("id"),
+						"jira_group.test", tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*issueLinkTypeResource)(nil)
+var _ resource.ResourceWithConfigure = (*issueLinkTypeResource)(nil)
+var _ resource.ResourceWithImportState = (*issueLinkTypeResource)(nil)
+
+// NewIssueLinkTypeResource returns the Terraform resource implementation for jira_issue_link_type.
+func NewIssueLinkTypeResource() resource.Resource { return &issueLinkTypeResource{} }
+
+type issueLinkTypeResource struct {
+	ServiceClient
+	linkTypeService jira.LinkTypeConnector
+	crudRunner      CRUDRunner[issueLinkTypeResourceModel, *models.LinkTypeScheme, *models.LinkTypeScheme]
+}
+
+func (r *issueLinkTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_link_type"
+}
+
+func (r *issueLinkTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.linkTypeService = provider.client.Issue.Link.Type
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *issueLinkTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira issue link type, such as `Blocks` (\"blocks\" / \"is blocked by\"), used to link work items to each other.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the issue link type. Automatically generated by Jira when the link type is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the issue link type. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"inward": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The description shown on the target work item of a link, for example `is blocked by`.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"outward": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The description shown on the source work item of a link, for example `blocks`.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *issueLinkTypeResource) createLinkType(ctx context.Context, p *models.LinkTypeScheme) (*models.LinkTypeScheme, *models.ResponseScheme, error) {
+	return r.linkTypeService.Create(ctx, p)
+}
+
+func (r *issueLinkTypeResource) getLinkType(ctx context.Context, id string) (*models.LinkTypeScheme, *models.ResponseScheme, error) {
+	return r.linkTypeService.Get(ctx, id)
+}
+
+func (r *issueLinkTypeResource) updateLinkType(ctx context.Context, id string, p *models.LinkTypeScheme) (*models.LinkTypeScheme, *models.ResponseScheme, error) {
+	return r.linkTypeService.Update(ctx, id, p)
+}
+
+func (r *issueLinkTypeResource) deleteLinkType(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return r.linkTypeService.Delete(ctx, id)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *issueLinkTypeResource) hooks() CRUDHooks[issueLinkTypeResourceModel, *models.LinkTypeScheme, *models.LinkTypeScheme] {
+	return CRUDHooks[issueLinkTypeResourceModel, *models.LinkTypeScheme, *models.LinkTypeScheme]{
+		BuildPayload: func(_ context.Context, st *issueLinkTypeResourceModel) (*models.LinkTypeScheme, diag.Diagnostics) {
+			return &models.LinkTypeScheme{
+				Name:    st.Name.ValueString(),
+				Inward:  st.Inward.ValueString(),
+				Outward: st.Outward.ValueString(),
+			}, nil
+		},
+		APICreate:               r.createLinkType,
+		APIRead:                 r.getLinkType,
+		APIUpdate:               r.updateLinkType,
+		APIDelete:               r.deleteLinkType,
+		ExtractID:               func(st *issueLinkTypeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapIssueLinkTypeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *issueLinkTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *issueLinkTypeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueLinkTypeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueLinkTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *issueLinkTypeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueLinkTypeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueLinkTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *issueLinkTypeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueLinkTypeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueLinkTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *issueLinkTypeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueLinkTypeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *issueLinkTypeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIssueLinkTypeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_issue_link_type.test"
+	name := acctest.RandomWithPrefix(accPrefixIssueLinkType)
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetIssueLinkTypeCfg(t, testhelpers.IssueLinkTypeTmplCfg{Name: name, Inward: "is blocked by", Outward: "blocks"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("inward"), knownvalue.StringExact("is blocked by")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("outward"), knownvalue.StringExact("blocks")),
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("data.jira_issue_link_types.by_name", tfjsonpath.New("link_types"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				Config: testhelpers.GetIssueLinkTypeCfg(t, testhelpers.IssueLinkTypeTmplCfg{Name: name + "-renamed", Inward: "is risk for", Outward: "has risk"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name+"-renamed")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("inward"), knownvalue.StringExact("is risk for")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("outward"), knownvalue.StringExact("has risk")),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// issueLinkTypeResourceModel models the Terraform schema/state for jira_issue_link_type. It is also the value type
+// of the link_types map of the jira_issue_link_types data source.
+type issueLinkTypeResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Inward  types.String `tfsdk:"inward"`
+	Outward types.String `tfsdk:"outward"`
+}
+
+func (m *issueLinkTypeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":      types.StringType,
+		"name":    types.StringType,
+		"inward":  types.StringType,
+		"outward": types.StringType,
+	}
+}
+
+// mapIssueLinkTypeToModel centralizes mapping for resources/data sources and matches CRUDHooks MapToState signature.
+func mapIssueLinkTypeToModel(_ context.Context, api *models.LinkTypeScheme, st *issueLinkTypeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no issue link type payload to map into state.")
+		return diags
+	}
+	*st = issueLinkTypeResourceModel{
+		ID:      types.StringValue(api.ID),
+		Name:    types.StringValue(api.Name),
+		Inward:  types.StringValue(api.Inward),
+		Outward: types.StringValue(api.Outward),
+	}
+	return diags
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*issueLinkTypesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*issueLinkTypesDataSource)(nil)
+
+// NewIssueLinkTypesDataSource returns the Terraform data source implementation for jira_issue_link_types.
+func NewIssueLinkTypesDataSource() datasource.DataSource { return &issueLinkTypesDataSource{} }
+
+type issueLinkTypesDataSource struct {
+	ServiceClient
+	linkTypeService jira.LinkTypeConnector
+}
+
+type issueLinkTypesDataSourceModel struct {
+	Ids       types.List `tfsdk:"ids"`
+	Names     types.List `tfsdk:"names"`
+	LinkTypes types.Map  `tfsdk:"link_types"`
+}
+
+func (d *issueLinkTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_link_types"
+}
+
+func (d *issueLinkTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List Jira issue link types with optional filtering by IDs or names. Results are returned as a map keyed by link type ID for stability across renames.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Filter by issue link type IDs. If omitted, all link types are returned.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("names")),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Filter by issue link type names (case-insensitive). If omitted, all link types are returned.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("ids")),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"link_types": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of issue link types keyed by ID. Each value includes id, name, inward and outward.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the issue link type.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the issue link type.",
+						},
+						"inward": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description shown on the target work item of a link.",
+						},
+						"outward": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description shown on the source work item of a link.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *issueLinkTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.linkTypeService = provider.client.Issue.Link.Type
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *issueLinkTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data issueLinkTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, deferIDs := getKnownStrings(ctx, data.Ids, "ids", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || deferIDs {
+		return
+	}
+	names, deferNames := getKnownStrings(ctx, data.Names, "names", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || deferNames {
+		return
+	}
+
+	// Fetch all link types; /issueLinkType is not paginated.
+	page, apiResp, err := d.linkTypeService.Gets(ctx)
+	if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "list issue link types", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+	linkTypes := page.IssueLinkTypes
+
+	// Deterministic order: by name (case-insensitive), then by id
+	// Even though the final output is a map, we sort here for deterministic processing and stable debug logs.
+	sort.SliceStable(linkTypes, func(i, j int) bool {
+		a := strings.ToLower(linkTypes[i].Name)
+		b := strings.ToLower(linkTypes[j].Name)
+		if a == b {
+			return linkTypes[i].ID < linkTypes[j].ID
+		}
+		return a < b
+	})
+
+	// Build filters and track found for warnings
+	idFilter := map[string]struct{}{}
+	for _, id := range uniqueStrings(ids) {
+		idFilter[id] = struct{}{}
+	}
+	nameFilter := map[string]struct{}{}
+	for _, n := range uniqueStrings(names) {
+		nameFilter[strings.ToLower(n)] = struct{}{}
+	}
+	foundIDs := map[string]struct{}{}
+	foundNames := map[string]struct{}{}
+
+	list := func(ctx context.Context) ([]*models.LinkTypeScheme, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		return linkTypes, diags
+	}
+
+	var runner CRUDRunner[issueLinkTypeResourceModel, *models.LinkTypeScheme, *models.LinkTypeScheme]
+	objMap, mapDiags := runner.DoListIssueLinkTypes(ctx, ListHooks[*models.LinkTypeScheme, issueLinkTypeResourceModel]{
+		List: list,
+		Filter: func(ctx context.Context, c *models.LinkTypeScheme) bool {
+			if len(idFilter) > 0 {
+				if _, ok := idFilter[c.ID]; ok {
+					foundIDs[c.ID] = struct{}{}
+					return true
+				}
+				return false
+			}
+			if len(nameFilter) > 0 {
+				ln := strings.ToLower(c.Name)
+				if _, ok := nameFilter[ln]; ok {
+					foundNames[ln] = struct{}{}
+					return true
+				}
+				return false
+			}
+			return true
+		},
+		KeyOf: func(c *models.LinkTypeScheme) string {
+			return c.ID
+		},
+		MapToOut: func(ctx context.Context, c *models.LinkTypeScheme) (issueLinkTypeResourceModel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var m issueLinkTypeResourceModel
+			diags.Append(mapIssueLinkTypeToModel(ctx, c, &m)...)
+			return m, diags
+		},
+		AttrTypes: (&issueLinkTypeResourceModel{}).AttributeTypes,
+	})
+	if mapDiags.HasError() {
+		resp.Diagnostics.Append(mapDiags...)
+		return
+	}
+
+	// Missing warnings
+	if len(idFilter) > 0 {
+		var missing []string
+		for id := range idFilter {
+			if _, ok := foundIDs[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			resp.Diagnostics.AddWarning(
+				"Some requested issue link type IDs were not found",
+				fmt.Sprintf("The following IDs were not found in Jira: %v. They will be omitted from the result.", missing),
+			)
+		}
+	}
+	if len(nameFilter) > 0 {
+		var missing []string
+		for n := range nameFilter {
+			if _, ok := foundNames[n]; !ok {
+				missing = append(missing, n)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			resp.Diagnostics.AddWarning(
+				"Some requested issue link type names were not found",
+				fmt.Sprintf("The following names were not found in Jira: %v. They will be omitted from the result.", missing),
+			)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.LinkTypes, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: (&issueLinkTypeResourceModel{}).AttributeTypes()}, objMap)
+	if diags.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("link_types"),
+			"Failed to build link types map",
+			fmt.Sprintf("Could not encode %d link types into state. See diagnostics for details.", len(objMap)),
+		)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
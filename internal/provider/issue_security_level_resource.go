@@ -0,0 +1,462 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*issueSecurityLevelResource)(nil)
+var _ resource.ResourceWithConfigure = (*issueSecurityLevelResource)(nil)
+var _ resource.ResourceWithImportState = (*issueSecurityLevelResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*issueSecurityLevelResource)(nil)
+
+// NewIssueSecurityLevelResource returns the Terraform resource implementation for jira_issue_security_level.
+func NewIssueSecurityLevelResource() resource.Resource { return &issueSecurityLevelResource{} }
+
+type issueSecurityLevelResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[issueSecurityLevelResourceModel, *issueSecurityLevelPayload, *issueSecurityLevelAPIModel]
+}
+
+func (r *issueSecurityLevelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_security_level"
+}
+
+func (r *issueSecurityLevelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *issueSecurityLevelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	memberTypes := make([]string, 0, len(issueSecurityMemberTypes))
+	for t := range issueSecurityMemberTypes {
+		memberTypes = append(memberTypes, t)
+	}
+	sort.Strings(memberTypes)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a security level of a `jira_issue_security_scheme` and the members who can see work items at that level.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the security level. Automatically generated by Jira when the level is created.",
+			},
+			"scheme_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the issue security scheme the level belongs to (for example `jira_issue_security_scheme.example.id`). Changing this forces a new resource.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric issue security scheme ID")},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the security level. Must be unique within the scheme.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the security level.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the level is the default level of the scheme, applied to new work items. Only one level per scheme should set this. Defaults to `false`.",
+			},
+			"members": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The members who can see work items at this level. Members are matched by type and parameter, so adding or removing one member only adds or removes that member.",
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: fmt.Sprintf("The member type. One of: `%s`.", strings.Join(memberTypes, "`, `")),
+							Validators:          []validator.String{stringvalidator.OneOf(memberTypes...)},
+						},
+						"parameter": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The member: the group ID for `group`, the project role ID for `projectRole`, the account ID for `user`, the custom field ID for `groupCustomField` and `userCustomField`, and optionally the application key for `applicationRole`. Must be omitted for the other member types.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that member parameters match their member types.
+func (r *issueSecurityLevelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg issueSecurityLevelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || cfg.Members.IsNull() || cfg.Members.IsUnknown() {
+		return
+	}
+	var members []issueSecurityMemberModel
+	resp.Diagnostics.Append(cfg.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, m := range members {
+		if m.Type.IsUnknown() || m.Parameter.IsUnknown() {
+			continue
+		}
+		needsParameter, known := issueSecurityMemberTypes[m.Type.ValueString()]
+		if !known {
+			continue
+		}
+		memberType := m.Type.ValueString()
+		switch {
+		case needsParameter && m.Parameter.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("members"), "Missing member parameter",
+				fmt.Sprintf("The %s member requires parameter.", memberType))
+		case !needsParameter && memberType != "applicationRole" && !m.Parameter.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("members"), "Unexpected member parameter",
+				fmt.Sprintf("The %s member does not take a parameter.", memberType))
+		}
+	}
+}
+
+// listLevels returns the levels of a scheme, optionally narrowed to a single level ID.
+func (r *issueSecurityLevelResource) listLevels(ctx context.Context, schemeID, levelID string) ([]*issueSecurityLevelAPIModel, *models.ResponseScheme, error) {
+	var (
+		levels []*issueSecurityLevelAPIModel
+		rs     *models.ResponseScheme
+	)
+	for startAt := 0; ; {
+		params := url.Values{"schemeId": {schemeID}, "startAt": {fmt.Sprint(startAt)}, "maxResults": {"100"}}
+		if levelID != "" {
+			params.Set("id", levelID)
+		}
+		var page issueSecurityLevelPage
+		var err error
+		rs, err = callJira(ctx, r.client, http.MethodGet, "rest/api/3/issuesecurityschemes/level?"+params.Encode(), nil, &page)
+		if err != nil {
+			return nil, rs, err
+		}
+		levels = append(levels, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return levels, rs, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// listMembers returns the members of a level.
+func (r *issueSecurityLevelResource) listMembers(ctx context.Context, schemeID, levelID string) ([]*issueSecurityLevelMemberAPIModel, *models.ResponseScheme, error) {
+	var (
+		members []*issueSecurityLevelMemberAPIModel
+		rs      *models.ResponseScheme
+	)
+	for startAt := 0; ; {
+		params := url.Values{"schemeId": {schemeID}, "levelId": {levelID}, "startAt": {fmt.Sprint(startAt)}, "maxResults": {"100"}}
+		var page issueSecurityLevelMemberPage
+		var err error
+		rs, err = callJira(ctx, r.client, http.MethodGet, "rest/api/3/issuesecurityschemes/level/member?"+params.Encode(), nil, &page)
+		if err != nil {
+			return nil, rs, err
+		}
+		members = append(members, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return members, rs, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// Wrapper functions to adapt the issue security level endpoints, which go-atlassian does not wrap.
+//
+// createLevel adds the level with its members. Jira does not return the new level, so it is looked up by name,
+// which is unique within the scheme.
+func (r *issueSecurityLevelResource) createLevel(ctx context.Context, p *issueSecurityLevelPayload) (*issueSecurityLevelAPIModel, *models.ResponseScheme, error) {
+	level := map[string]any{"name": p.Name, "description": p.Description, "isDefault": p.IsDefault}
+	if len(p.Members) > 0 {
+		level["members"] = p.Members
+	}
+	body := map[string]any{"levels": []any{level}}
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level", p.SchemeID), body, nil); err != nil {
+		return nil, rs, err
+	}
+	levels, rs, err := r.listLevels(ctx, p.SchemeID, "")
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, l := range levels {
+		if l != nil && l.Name == p.Name {
+			return r.getLevel(ctx, p.SchemeID+"/"+l.ID.String())
+		}
+	}
+	return nil, rs, fmt.Errorf("security level %q was not found in scheme %s after creation", p.Name, p.SchemeID)
+}
+
+// getLevel reads the level by its "scheme_id/level_id" key, including its members.
+func (r *issueSecurityLevelResource) getLevel(ctx context.Context, key string) (*issueSecurityLevelAPIModel, *models.ResponseScheme, error) {
+	schemeID, levelID, err := parseIssueSecurityLevelKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	levels, rs, err := r.listLevels(ctx, schemeID, levelID)
+	if err != nil {
+		return nil, rs, err
+	}
+	var api *issueSecurityLevelAPIModel
+	for _, l := range levels {
+		if l != nil && l.ID.String() == levelID {
+			api = l
+		}
+	}
+	if api == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("security level %s not found", key)
+	}
+	// The level list does not always include the scheme ID, while state and import rely on it.
+	if api.SchemeID == "" {
+		api.SchemeID = json.Number(schemeID)
+	}
+
+	api.Members, rs, err = r.listMembers(ctx, schemeID, levelID)
+	if err != nil {
+		return nil, rs, err
+	}
+	return api, rs, nil
+}
+
+// updateLevel updates the name and description, adds the members missing from Jira, removes the ones no longer
+// configured and moves the scheme default to or away from the level.
+func (r *issueSecurityLevelResource) updateLevel(ctx context.Context, key string, p *issueSecurityLevelPayload) (*issueSecurityLevelAPIModel, *models.ResponseScheme, error) {
+	current, rs, err := r.getLevel(ctx, key)
+	if err != nil {
+		return nil, rs, err
+	}
+	schemeID, levelID := current.SchemeID.String(), current.ID.String()
+	levelEndpoint := fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level/%s", schemeID, levelID)
+
+	if current.Name != p.Name || current.Description != p.Description {
+		body := map[string]string{"name": p.Name, "description": p.Description}
+		if rs, err := callJira(ctx, r.client, http.MethodPut, levelEndpoint, body, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	existing := make(map[string]string, len(current.Members))
+	for _, m := range current.Members {
+		if m != nil {
+			existing[issueSecurityMemberKey(m.Holder.Type, m.Holder.Parameter)] = m.ID.String()
+		}
+	}
+	planned := make(map[string]struct{}, len(p.Members))
+	var added []issueSecurityMember
+	for _, m := range p.Members {
+		k := issueSecurityMemberKey(m.Type, m.Parameter)
+		planned[k] = struct{}{}
+		if _, ok := existing[k]; !ok {
+			added = append(added, m)
+		}
+	}
+	if len(added) > 0 {
+		if rs, err := callJira(ctx, r.client, http.MethodPut, levelEndpoint+"/member", map[string]any{"members": added}, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+	for k, memberID := range existing {
+		if _, ok := planned[k]; ok {
+			continue
+		}
+		if rs, err := callJira(ctx, r.client, http.MethodDelete, levelEndpoint+"/member/"+memberID, nil, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	if current.IsDefault != p.IsDefault {
+		// A null default level clears the scheme default. When the default moves to another level in the same
+		// apply, that level may already have taken it over, so the level is re-read before clearing.
+		var defaultLevelID any
+		if p.IsDefault {
+			defaultLevelID = levelID
+		} else if latest, rs, err := r.listLevels(ctx, schemeID, levelID); err != nil {
+			return nil, rs, err
+		} else if len(latest) == 0 || latest[0] == nil || !latest[0].IsDefault {
+			return r.getLevel(ctx, key)
+		}
+		body := map[string]any{"defaultValues": []any{map[string]any{"issueSecuritySchemeId": schemeID, "defaultLevelId": defaultLevelID}}}
+		if rs, err := callJira(ctx, r.client, http.MethodPut, "rest/api/3/issuesecurityschemes/level/default", body, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getLevel(ctx, key)
+}
+
+// deleteLevel removes the level. Jira removes levels asynchronously and redirects to the task, which is awaited
+// so the scheme can be deleted right after its levels.
+func (r *issueSecurityLevelResource) deleteLevel(ctx context.Context, key string) (*models.ResponseScheme, error) {
+	schemeID, levelID, err := parseIssueSecurityLevelKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var task models.TaskScheme
+	rs, err := callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level/%s", schemeID, levelID), nil, &task)
+	if err != nil || task.ID == "" {
+		return rs, err
+	}
+	_, rs, err = waitForJiraTask(ctx, r.client, task.ID, "delete issue security level")
+	return rs, err
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *issueSecurityLevelResource) hooks() CRUDHooks[issueSecurityLevelResourceModel, *issueSecurityLevelPayload, *issueSecurityLevelAPIModel] {
+	return CRUDHooks[issueSecurityLevelResourceModel, *issueSecurityLevelPayload, *issueSecurityLevelAPIModel]{
+		BuildPayload: func(ctx context.Context, st *issueSecurityLevelResourceModel) (*issueSecurityLevelPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			p := &issueSecurityLevelPayload{
+				SchemeID:    st.SchemeID.ValueString(),
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+				IsDefault:   st.IsDefault.ValueBool(),
+			}
+			var members []issueSecurityMemberModel
+			if !st.Members.IsNull() && !st.Members.IsUnknown() {
+				diags.Append(st.Members.ElementsAs(ctx, &members, false)...)
+			}
+			for _, m := range members {
+				p.Members = append(p.Members, issueSecurityMember{Type: m.Type.ValueString(), Parameter: m.Parameter.ValueString()})
+			}
+			return p, diags
+		},
+		APICreate:               r.createLevel,
+		APIRead:                 r.getLevel,
+		APIUpdate:               r.updateLevel,
+		APIDelete:               r.deleteLevel,
+		ExtractID:               func(st *issueSecurityLevelResourceModel) string { return st.issueSecurityLevelKey() },
+		MapToState:              mapIssueSecurityLevelToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *issueSecurityLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecurityLevelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecurityLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecurityLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState accepts a "scheme_id/level_id" import ID. The ID is validated here so a malformed ID is reported
+// as such instead of as a failed read.
+func (r *issueSecurityLevelResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	schemeID, levelID, err := parseIssueSecurityLevelKey(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected an import ID of the form scheme_id/level_id, for example 10000/10021: %s", err))
+		return
+	}
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		schemeID+"/"+levelID,
+		func(ctx context.Context, src *issueSecurityLevelResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*issueSecuritySchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*issueSecuritySchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*issueSecuritySchemeResource)(nil)
+
+// NewIssueSecuritySchemeResource returns the Terraform resource implementation for jira_issue_security_scheme.
+func NewIssueSecuritySchemeResource() resource.Resource { return &issueSecuritySchemeResource{} }
+
+type issueSecuritySchemeResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[issueSecuritySchemeResourceModel, *issueSecuritySchemePayload, *issueSecuritySchemeAPIModel]
+}
+
+func (r *issueSecuritySchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_security_scheme"
+}
+
+func (r *issueSecuritySchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *issueSecuritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira issue security scheme. Add security levels with `jira_issue_security_level` and assign the scheme to a project with `issue_security_scheme_id` on `jira_project`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the issue security scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the issue security scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 60)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the issue security scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the issue security scheme endpoints, which go-atlassian does not wrap.
+func (r *issueSecuritySchemeResource) createScheme(ctx context.Context, p *issueSecuritySchemePayload) (*issueSecuritySchemeAPIModel, *models.ResponseScheme, error) {
+	var created issueSecuritySchemeAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/issuesecurityschemes", p, &created); err != nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, created.ID.String())
+}
+
+func (r *issueSecuritySchemeResource) getScheme(ctx context.Context, id string) (*issueSecuritySchemeAPIModel, *models.ResponseScheme, error) {
+	var scheme issueSecuritySchemeAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s", id), nil, &scheme)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &scheme, rs, nil
+}
+
+func (r *issueSecuritySchemeResource) updateScheme(ctx context.Context, id string, p *issueSecuritySchemePayload) (*issueSecuritySchemeAPIModel, *models.ResponseScheme, error) {
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s", id), p, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *issueSecuritySchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s", id), nil, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *issueSecuritySchemeResource) hooks() CRUDHooks[issueSecuritySchemeResourceModel, *issueSecuritySchemePayload, *issueSecuritySchemeAPIModel] {
+	return CRUDHooks[issueSecuritySchemeResourceModel, *issueSecuritySchemePayload, *issueSecuritySchemeAPIModel]{
+		BuildPayload: func(_ context.Context, st *issueSecuritySchemeResourceModel) (*issueSecuritySchemePayload, diag.Diagnostics) {
+			return &issueSecuritySchemePayload{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}, nil
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *issueSecuritySchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapIssueSecuritySchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *issueSecuritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecuritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecuritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecuritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueSecuritySchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *issueSecuritySchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIssueSecuritySchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	schemeName := "jira_issue_security_scheme.test"
+	internalName := "jira_issue_security_level.internal"
+	restrictedName := "jira_issue_security_level.restricted"
+	name := acctest.RandomWithPrefix(accPrefixIssueSecurity)
+	sameLevelID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetIssueSecuritySchemeCfg(t, testhelpers.IssueSecuritySchemeTmplCfg{Name: name}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(schemeName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.CompareValuePairs(internalName, tfjsonpath.New("scheme_id"), schemeName, tfjsonpath.New("id"), compare.ValuesSame()),
+					sameLevelID.AddStateValue(internalName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(internalName, tfjsonpath.New("is_default"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(internalName, tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{"type": knownvalue.StringExact("reporter"), "parameter": knownvalue.Null()}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{"type": knownvalue.StringExact("projectLead"), "parameter": knownvalue.Null()}),
+					})),
+					statecheck.ExpectKnownValue(restrictedName, tfjsonpath.New("is_default"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: testhelpers.GetIssueSecuritySchemeCfg(t, testhelpers.IssueSecuritySchemeTmplCfg{
+					Name:        name,
+					Description: "Updated issue security scheme description",
+					Updated:     true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(schemeName, tfjsonpath.New("description"), knownvalue.StringExact("Updated issue security scheme description")),
+					sameLevelID.AddStateValue(internalName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(internalName, tfjsonpath.New("is_default"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(internalName, tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{"type": knownvalue.StringExact("reporter"), "parameter": knownvalue.Null()}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{"type": knownvalue.StringExact("assignee"), "parameter": knownvalue.Null()}),
+					})),
+					statecheck.ExpectKnownValue(restrictedName, tfjsonpath.New("is_default"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    schemeName,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      internalName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[internalName]
+					if !ok {
+						return "", fmt.Errorf("resource %s not found in state", internalName)
+					}
+					return rs.Primary.Attributes["scheme_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				ImportState:   true,
+				ResourceName:  internalName,
+				ImportStateId: "not-a-level",
+				ExpectError:   regexp.MustCompile(`Invalid import ID`),
+			},
+		},
+	})
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// issueSecurityMemberTypes lists the member types a security level accepts, mapped to whether the member requires
+// a parameter (a group ID, project role ID, account ID or custom field ID). The parameter of an applicationRole
+// member is optional: without one the level applies to any application role.
+var issueSecurityMemberTypes = map[string]bool{
+	"applicationRole":  false,
+	"assignee":         false,
+	"group":            true,
+	"groupCustomField": true,
+	"projectLead":      false,
+	"projectRole":      true,
+	"reporter":         false,
+	"user":             true,
+	"userCustomField":  true,
+}
+
+// issueSecuritySchemeResourceModel models the Terraform schema/state for jira_issue_security_scheme.
+type issueSecuritySchemeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *issueSecuritySchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+	}
+}
+
+// issueSecuritySchemePayload carries the planned scheme for create/update. go-atlassian has no issue security
+// scheme service, so the provider defines its own request and response types.
+type issueSecuritySchemePayload struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// issueSecuritySchemeAPIModel is the issue security scheme as returned by Jira. Jira returns IDs as numbers from
+// some endpoints and as strings from others, so they are decoded as json.Number.
+type issueSecuritySchemeAPIModel struct {
+	ID          json.Number `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+}
+
+// mapIssueSecuritySchemeToModel centralizes mapping for the issue security scheme resource and matches CRUDHooks MapToState signature.
+func mapIssueSecuritySchemeToModel(_ context.Context, api *issueSecuritySchemeAPIModel, st *issueSecuritySchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no issue security scheme payload to map into state.")
+		return diags
+	}
+	*st = issueSecuritySchemeResourceModel{
+		ID:          types.StringValue(api.ID.String()),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+	}
+	return diags
+}
+
+// issueSecurityLevelResourceModel models the Terraform schema/state for jira_issue_security_level.
+type issueSecurityLevelResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	SchemeID    types.String `tfsdk:"scheme_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	Members     types.Set    `tfsdk:"members"`
+}
+
+// issueSecurityMemberModel models a single element of the members set.
+type issueSecurityMemberModel struct {
+	Type      types.String `tfsdk:"type"`
+	Parameter types.String `tfsdk:"parameter"`
+}
+
+func (m *issueSecurityMemberModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":      types.StringType,
+		"parameter": types.StringType,
+	}
+}
+
+func (m *issueSecurityLevelResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"scheme_id":   types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"is_default":  types.BoolType,
+		"members":     types.SetType{ElemType: types.ObjectType{AttrTypes: (&issueSecurityMemberModel{}).AttributeTypes()}},
+	}
+}
+
+// issueSecurityLevelKey returns the "scheme_id/level_id" key the level endpoints are addressed by; it is also the import ID.
+func (m *issueSecurityLevelResourceModel) issueSecurityLevelKey() string {
+	return m.SchemeID.ValueString() + "/" + m.ID.ValueString()
+}
+
+// parseIssueSecurityLevelKey splits a "scheme_id/level_id" key into its numeric parts.
+func parseIssueSecurityLevelKey(key string) (schemeID, levelID string, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 2 || !numericIDRegex.MatchString(parts[0]) || !numericIDRegex.MatchString(parts[1]) {
+		return "", "", fmt.Errorf("invalid issue security level id %q: expected format scheme_id/level_id", key)
+	}
+	return parts[0], parts[1], nil
+}
+
+// issueSecurityMember is a level member as sent to Jira.
+type issueSecurityMember struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// issueSecurityLevelPayload carries the planned level for create/update.
+type issueSecurityLevelPayload struct {
+	SchemeID    string
+	Name        string
+	Description string
+	IsDefault   bool
+	Members     []issueSecurityMember
+}
+
+// issueSecurityLevelAPIModel is the security level as returned by Jira, together with its members.
+type issueSecurityLevelAPIModel struct {
+	ID          json.Number                         `json:"id"`
+	SchemeID    json.Number                         `json:"issueSecuritySchemeId"`
+	Name        string                              `json:"name"`
+	Description string                              `json:"description"`
+	IsDefault   bool                                `json:"isDefault"`
+	Members     []*issueSecurityLevelMemberAPIModel `json:"-"`
+}
+
+// issueSecurityLevelMemberAPIModel is a level member as returned by Jira.
+type issueSecurityLevelMemberAPIModel struct {
+	ID     json.Number `json:"id"`
+	Holder struct {
+		Type      string `json:"type"`
+		Parameter string `json:"parameter"`
+	} `json:"holder"`
+}
+
+// issueSecurityMemberKey identifies a member by type and parameter, which is how members are matched between
+// configuration and Jira.
+func issueSecurityMemberKey(memberType, parameter string) string {
+	return memberType + "|" + parameter
+}
+
+// mapIssueSecurityLevelToModel centralizes mapping for the issue security level resource and matches CRUDHooks MapToState signature.
+func mapIssueSecurityLevelToModel(ctx context.Context, api *issueSecurityLevelAPIModel, st *issueSecurityLevelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no issue security level payload to map into state.")
+		return diags
+	}
+
+	memberType := types.ObjectType{AttrTypes: (&issueSecurityMemberModel{}).AttributeTypes()}
+	members := make([]issueSecurityMemberModel, 0, len(api.Members))
+	for _, m := range api.Members {
+		if m == nil {
+			continue
+		}
+		members = append(members, issueSecurityMemberModel{
+			Type:      types.StringValue(m.Holder.Type),
+			Parameter: stringOrNull(m.Holder.Parameter),
+		})
+	}
+	memberSet := types.SetNull(memberType)
+	if len(members) > 0 {
+		var d diag.Diagnostics
+		memberSet, d = types.SetValueFrom(ctx, memberType, members)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*st = issueSecurityLevelResourceModel{
+		ID:          types.StringValue(api.ID.String()),
+		SchemeID:    types.StringValue(api.SchemeID.String()),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+		IsDefault:   types.BoolValue(api.IsDefault),
+		Members:     memberSet,
+	}
+	return diags
+}
+
+// issueSecurityLevelPage is a page of security levels as returned by Jira.
+type issueSecurityLevelPage struct {
+	IsLast bool                          `json:"isLast"`
+	Values []*issueSecurityLevelAPIModel `json:"values"`
+}
+
+// issueSecurityLevelMemberPage is a page of security level members as returned by Jira.
+type issueSecurityLevelMemberPage struct {
+	IsLast bool                                `json:"isLast"`
+	Values []*issueSecurityLevelMemberAPIModel `json:"values"`
+}
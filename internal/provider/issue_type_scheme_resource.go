@@ -0,0 +1,476 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*issueTypeSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*issueTypeSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*issueTypeSchemeResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*issueTypeSchemeResource)(nil)
+
+// issueTypeSchemePageSize is the page size used when listing scheme items, schemes and project associations.
+const issueTypeSchemePageSize = 50
+
+// NewIssueTypeSchemeResource returns the Terraform resource implementation for jira_issue_type_scheme.
+func NewIssueTypeSchemeResource() resource.Resource { return &issueTypeSchemeResource{} }
+
+type issueTypeSchemeResource struct {
+	ServiceClient
+	schemeService jira.TypeSchemeConnector
+	crudRunner    CRUDRunner[issueTypeSchemeResourceModel, *models.IssueTypeSchemePayloadScheme, *issueTypeSchemeAPIModel]
+}
+
+// workTypeInUseError marks a failed removal of a work type from a scheme so the diagnostic can explain the
+// usual cause instead of only echoing Jira's response.
+type workTypeInUseError struct {
+	workTypeID string
+	err        error
+}
+
+func (e *workTypeInUseError) Error() string {
+	return fmt.Sprintf("removing work type %s from the scheme: %v", e.workTypeID, e.err)
+}
+
+func (e *workTypeInUseError) Unwrap() error { return e.err }
+
+func (r *issueTypeSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_type_scheme"
+}
+
+func (r *issueTypeSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.schemeService = provider.client.Issue.Type.Scheme
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *issueTypeSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira issue type scheme, the ordered set of work types available to the projects that use it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the issue type scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the issue type scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the issue type scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(4000)},
+			},
+			"default_work_type_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "ID of the work type selected by default when creating work items. Must be one of `work_type_ids`.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric work type ID")},
+			},
+			"work_type_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Ordered list of work type IDs in the scheme (for example `jira_work_type.example.id`). The order is the order Jira shows them in.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric work type ID")),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of classic projects that use this scheme. When omitted, project assignment is not managed. Projects removed from the set are moved back to the Default Issue Type Scheme.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")),
+				},
+			},
+		},
+	}
+}
+
+func (r *issueTypeSchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data issueTypeSchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DefaultWorkTypeID.IsNull() || data.DefaultWorkTypeID.IsUnknown() {
+		return
+	}
+	workTypeIDs, deferEval := getKnownStrings(ctx, data.WorkTypeIDs, "work_type_ids", &resp.Diagnostics)
+	if deferEval || resp.Diagnostics.HasError() {
+		return
+	}
+	if !slices.Contains(workTypeIDs, data.DefaultWorkTypeID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_work_type_id"),
+			"Default work type not in scheme",
+			fmt.Sprintf("The default work type %q must also be listed in 'work_type_ids'.", data.DefaultWorkTypeID.ValueString()),
+		)
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *issueTypeSchemeResource) createScheme(ctx context.Context, p *models.IssueTypeSchemePayloadScheme) (*issueTypeSchemeAPIModel, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, created.IssueTypeSchemeID)
+}
+
+// getScheme reads the scheme and its work types in scheme order. Jira has no single-scheme endpoint, so a
+// missing scheme is reported as a synthetic 404.
+func (r *issueTypeSchemeResource) getScheme(ctx context.Context, id string) (*issueTypeSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid issue type scheme id %q: %w", id, err)
+	}
+	page, rs, err := r.schemeService.Gets(ctx, []int{i}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	var scheme *models.IssueTypeSchemeScheme
+	for _, v := range page.Values {
+		if v != nil && v.ID == id {
+			scheme = v
+		}
+	}
+	if scheme == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("issue type scheme %s not found", id)
+	}
+
+	api := &issueTypeSchemeAPIModel{IssueTypeSchemeScheme: scheme, WorkTypeIDs: []string{}}
+	for startAt := 0; ; startAt += issueTypeSchemePageSize {
+		items, itemsRS, err := r.schemeService.Items(ctx, []int{i}, startAt, issueTypeSchemePageSize)
+		if err != nil {
+			return nil, itemsRS, err
+		}
+		for _, item := range items.Values {
+			if item != nil && item.IssueTypeSchemeID == id {
+				api.WorkTypeIDs = append(api.WorkTypeIDs, item.IssueTypeID)
+			}
+		}
+		if items.IsLast || len(items.Values) == 0 {
+			break
+		}
+	}
+	return api, rs, nil
+}
+
+// updateScheme reconciles the work types before and after the metadata update so the default work type is
+// always part of the scheme, then restores the configured order.
+func (r *issueTypeSchemeResource) updateScheme(ctx context.Context, id string, p *models.IssueTypeSchemePayloadScheme) (*issueTypeSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid issue type scheme id %q: %w", id, err)
+	}
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+
+	var toAppend []int
+	for _, wt := range p.IssueTypeIDs {
+		if slices.Contains(current.WorkTypeIDs, wt) {
+			continue
+		}
+		n, err := strconv.Atoi(wt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid work type id %q: %w", wt, err)
+		}
+		toAppend = append(toAppend, n)
+	}
+	if len(toAppend) > 0 {
+		if rs, err := r.schemeService.Append(ctx, i, toAppend); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	// The update endpoint only accepts name, description and default work type.
+	rs, err = r.schemeService.Update(ctx, i, &models.IssueTypeSchemePayloadScheme{
+		Name:               p.Name,
+		Description:        p.Description,
+		DefaultIssueTypeID: p.DefaultIssueTypeID,
+	})
+	if err != nil {
+		return nil, rs, err
+	}
+
+	for _, wt := range current.WorkTypeIDs {
+		if slices.Contains(p.IssueTypeIDs, wt) {
+			continue
+		}
+		n, err := strconv.Atoi(wt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid work type id %q: %w", wt, err)
+		}
+		if rs, err := r.schemeService.Remove(ctx, i, n); err != nil {
+			return nil, rs, &workTypeInUseError{workTypeID: wt, err: err}
+		}
+	}
+
+	updated, rs, err := r.getScheme(ctx, id)
+	if err != nil || slices.Equal(updated.WorkTypeIDs, p.IssueTypeIDs) {
+		return updated, rs, err
+	}
+	rs, err = r.schemeService.Reorder(ctx, id, &models.IssueTypeSchemeOrderPayloadScheme{
+		Position:     models.SchemePositionFirst,
+		IssueTypeIDs: p.IssueTypeIDs,
+	})
+	if err != nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *issueTypeSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue type scheme id %q: %w", id, err)
+	}
+	return r.schemeService.Delete(ctx, i)
+}
+
+// projects adapts the issue type scheme service for the shared project assignment helpers.
+func (r *issueTypeSchemeResource) projects() schemeProjectAssignment {
+	return schemeProjectAssignment{
+		pageSize: issueTypeSchemePageSize,
+		list: func(ctx context.Context, projectIDs []int, startAt, maxResults int) ([]schemeProjectAssociation, bool, *models.ResponseScheme, error) {
+			page, rs, err := r.schemeService.Projects(ctx, projectIDs, startAt, maxResults)
+			if err != nil {
+				return nil, false, rs, err
+			}
+			associations := make([]schemeProjectAssociation, 0, len(page.Values))
+			for _, v := range page.Values {
+				if v != nil && v.IssueTypeScheme != nil {
+					associations = append(associations, schemeProjectAssociation{SchemeID: v.IssueTypeScheme.ID, ProjectIDs: v.ProjectIDs})
+				}
+			}
+			return associations, page.IsLast, rs, nil
+		},
+		assign:          r.schemeService.Assign,
+		defaultSchemeID: r.defaultSchemeID,
+	}
+}
+
+// defaultSchemeID looks up the Default Issue Type Scheme, which projects fall back to when released.
+func (r *issueTypeSchemeResource) defaultSchemeID(ctx context.Context) (string, *models.ResponseScheme, error) {
+	for startAt := 0; ; startAt += issueTypeSchemePageSize {
+		page, rs, err := r.schemeService.Gets(ctx, nil, startAt, issueTypeSchemePageSize)
+		if err != nil {
+			return "", rs, err
+		}
+		for _, v := range page.Values {
+			if v != nil && v.IsDefault {
+				return v.ID, rs, nil
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			return "", &models.ResponseScheme{Code: http.StatusNotFound}, errors.New("default issue type scheme not found")
+		}
+	}
+}
+
+// syncProjects returns a post hook that records which managed projects use the scheme. With assign set, it
+// first assigns the planned projects that do not use the scheme yet.
+func (r *issueTypeSchemeResource) syncProjects(assign bool) PostAPIHook[issueTypeSchemeResourceModel, *issueTypeSchemeAPIModel] {
+	return func(ctx context.Context, api *issueTypeSchemeAPIModel, st *issueTypeSchemeResourceModel) (*issueTypeSchemeAPIModel, *models.ResponseScheme, error) {
+		using, rs, err := r.projects().syncProjects(ctx, api.ID, st.ProjectIDs, assign)
+		if err != nil {
+			return nil, rs, err
+		}
+		if using != nil {
+			api.ProjectIDs = using
+		}
+		return api, rs, nil
+	}
+}
+
+// ensureWithWorkTypeHint behaves like ensureWith, but turns a failed work type removal into an attribute
+// diagnostic that explains how to unblock it.
+func ensureWithWorkTypeHint(diags *diag.Diagnostics) func(ctx context.Context, action string, resp *models.ResponseScheme, err error, opts *EnsureSuccessOrDiagOptions) bool {
+	ensure := ensureWith(diags)
+	return func(ctx context.Context, action string, resp *models.ResponseScheme, err error, opts *EnsureSuccessOrDiagOptions) bool {
+		var inUse *workTypeInUseError
+		if !errors.As(err, &inUse) {
+			return ensure(ctx, action, resp, err, opts)
+		}
+		summary, detail := ErrorFromSchemeWithOptions(ctx, action, resp, err, opts)
+		diags.AddAttributeError(
+			path.Root("work_type_ids"),
+			summary,
+			detail+fmt.Sprintf("\nHint: Jira refuses to remove work type %s while work items in projects using this scheme still have it. "+
+				"Move those work items to another work type (bulk change in Jira), or keep the work type in 'work_type_ids', then apply again.", inUse.workTypeID),
+		)
+		return false
+	}
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *issueTypeSchemeResource) hooks() CRUDHooks[issueTypeSchemeResourceModel, *models.IssueTypeSchemePayloadScheme, *issueTypeSchemeAPIModel] {
+	return CRUDHooks[issueTypeSchemeResourceModel, *models.IssueTypeSchemePayloadScheme, *issueTypeSchemeAPIModel]{
+		BuildPayload: func(ctx context.Context, st *issueTypeSchemeResourceModel) (*models.IssueTypeSchemePayloadScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var workTypeIDs []string
+			diags.Append(st.WorkTypeIDs.ElementsAs(ctx, &workTypeIDs, false)...)
+			p := &models.IssueTypeSchemePayloadScheme{
+				Name:         st.Name.ValueString(),
+				Description:  st.Description.ValueString(),
+				IssueTypeIDs: workTypeIDs,
+			}
+			if !st.DefaultWorkTypeID.IsUnknown() {
+				p.DefaultIssueTypeID = st.DefaultWorkTypeID.ValueString()
+			}
+			return p, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *issueTypeSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapIssueTypeSchemeToModel,
+		PostCreate:              r.syncProjects(true),
+		PostRead:                r.syncProjects(false),
+		PostUpdate:              r.syncProjects(true),
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *issueTypeSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	var state, plan issueTypeSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Projects dropped from project_ids move back to the Default Issue Type Scheme.
+	rs, err := r.projects().releaseProjects(ctx, state.ID.ValueString(), state.ProjectIDs, plan.ProjectIDs)
+	if !ensureWith(&resp.Diagnostics)(ctx, "release projects from issue type scheme", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWithWorkTypeHint(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *issueTypeSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
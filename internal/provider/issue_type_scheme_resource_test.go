@@ -0,0 +1,84 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIssueTypeSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_issue_type_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixIssueTypeScheme)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetIssueTypeSchemeCfg(t, testhelpers.IssueTypeSchemeTmplCfg{
+					Name:         name,
+					WorkTypeName: workTypeName,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_ids"), knownvalue.ListSizeExact(2)),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("work_type_ids").AtSliceIndex(0), "jira_work_type.first", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("project_ids"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetIssueTypeSchemeCfg(t, testhelpers.IssueTypeSchemeTmplCfg{
+					Name:         name,
+					Description:  "Updated scheme description",
+					WorkTypeName: workTypeName,
+					Reverse:      true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated scheme description")),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("work_type_ids").AtSliceIndex(0), "jira_work_type.second", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("default_work_type_id"), "jira_work_type.second", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
+
+func TestAccIssueTypeSchemeResource_negative(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jira_issue_type_scheme" "bad" {
+  name                 = "` + acctest.RandomWithPrefix(accPrefixIssueTypeScheme) + `"
+  default_work_type_id = "10002"
+  work_type_ids        = ["10001"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Default work type not in scheme`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
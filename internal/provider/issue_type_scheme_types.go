@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// issueTypeSchemeResourceModel models the Terraform schema/state for jira_issue_type_scheme.
+type issueTypeSchemeResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	DefaultWorkTypeID types.String `tfsdk:"default_work_type_id"`
+	WorkTypeIDs       types.List   `tfsdk:"work_type_ids"`
+	ProjectIDs        types.Set    `tfsdk:"project_ids"`
+}
+
+func (m *issueTypeSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                   types.StringType,
+		"name":                 types.StringType,
+		"description":          types.StringType,
+		"default_work_type_id": types.StringType,
+		"work_type_ids":        types.ListType{ElemType: types.StringType},
+		"project_ids":          types.SetType{ElemType: types.StringType},
+	}
+}
+
+// issueTypeSchemeAPIModel wraps the go-atlassian issue type scheme with its ordered work type IDs and the
+// managed projects using it; Jira serves both from separate endpoints. ProjectIDs stays nil when project
+// assignment is not managed.
+type issueTypeSchemeAPIModel struct {
+	*models.IssueTypeSchemeScheme
+	WorkTypeIDs []string
+	ProjectIDs  []string
+}
+
+// mapIssueTypeSchemeToModel centralizes mapping for the issue type scheme resource and matches CRUDHooks MapToState signature.
+func mapIssueTypeSchemeToModel(ctx context.Context, api *issueTypeSchemeAPIModel, st *issueTypeSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.IssueTypeSchemeScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no issue type scheme payload to map into state.")
+		return diags
+	}
+
+	workTypeIDs, d := types.ListValueFrom(ctx, types.StringType, api.WorkTypeIDs)
+	diags.Append(d...)
+
+	projectIDs := types.SetNull(types.StringType)
+	if api.ProjectIDs != nil {
+		projectIDs, d = types.SetValueFrom(ctx, types.StringType, api.ProjectIDs)
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = issueTypeSchemeResourceModel{
+		ID:                types.StringValue(api.ID),
+		Name:              types.StringValue(api.Name),
+		Description:       stringOrNull(api.Description),
+		DefaultWorkTypeID: stringOrNull(api.DefaultIssueTypeID),
+		WorkTypeIDs:       workTypeIDs,
+		ProjectIDs:        projectIDs,
+	}
+	return diags
+}
@@ -0,0 +1,422 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*issueTypeScreenSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*issueTypeScreenSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*issueTypeScreenSchemeResource)(nil)
+
+const (
+	// issueTypeScreenSchemePageSize is the page size used when listing mappings and project associations.
+	issueTypeScreenSchemePageSize = 50
+	// issueTypeScreenSchemeDefaultMapping is the pseudo work type ID Jira uses for the default screen scheme mapping.
+	issueTypeScreenSchemeDefaultMapping = "default"
+	// defaultIssueTypeScreenSchemeID is the ID of Jira's built-in Default Issue Type Screen Scheme.
+	defaultIssueTypeScreenSchemeID = "1"
+)
+
+// NewIssueTypeScreenSchemeResource returns the Terraform resource implementation for jira_issue_type_screen_scheme.
+func NewIssueTypeScreenSchemeResource() resource.Resource { return &issueTypeScreenSchemeResource{} }
+
+type issueTypeScreenSchemeResource struct {
+	ServiceClient
+	schemeService jira.TypeScreenSchemeConnector
+	crudRunner    CRUDRunner[issueTypeScreenSchemeResourceModel, *models.IssueTypeScreenSchemePayloadScheme, *issueTypeScreenSchemeAPIModel]
+}
+
+func (r *issueTypeScreenSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_type_screen_scheme"
+}
+
+func (r *issueTypeScreenSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.schemeService = provider.client.Issue.Type.ScreenScheme
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *issueTypeScreenSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira issue type screen scheme, which selects the screen scheme used for each work type in the projects that use it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the issue type screen scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the issue type screen scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the issue type screen scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"default_screen_scheme_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the screen scheme used for work types without an explicit mapping (for example `jira_screen_scheme.example.id`).",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric screen scheme ID")},
+			},
+			"work_type_screen_schemes": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Map of work type ID (for example `jira_work_type.example.id`) to screen scheme ID.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric work type ID")),
+					mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric screen scheme ID")),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of classic projects that use this scheme. When omitted, project assignment is not managed. Projects removed from the set, or still listed when the scheme is destroyed, are moved back to the Default Issue Type Screen Scheme.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")),
+				},
+			},
+		},
+	}
+}
+
+// payloadMappings splits a payload's mappings into the default screen scheme ID and the work type mappings.
+func payloadMappings(p *models.IssueTypeScreenSchemePayloadScheme) (defaultID string, mappings map[string]string) {
+	mappings = map[string]string{}
+	for _, m := range p.IssueTypeMappings {
+		if m.IssueTypeID == issueTypeScreenSchemeDefaultMapping {
+			defaultID = m.ScreenSchemeID
+			continue
+		}
+		mappings[m.IssueTypeID] = m.ScreenSchemeID
+	}
+	return defaultID, mappings
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *issueTypeScreenSchemeResource) createScheme(ctx context.Context, p *models.IssueTypeScreenSchemePayloadScheme) (*issueTypeScreenSchemeAPIModel, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, created.ID)
+}
+
+// getScheme reads the scheme and its mappings. Jira has no single-scheme endpoint, so a missing scheme is
+// reported as a synthetic 404.
+func (r *issueTypeScreenSchemeResource) getScheme(ctx context.Context, id string) (*issueTypeScreenSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid issue type screen scheme id %q: %w", id, err)
+	}
+	page, rs, err := r.schemeService.Gets(ctx, &models.ScreenSchemeParamsScheme{IDs: []int{i}}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	var scheme *models.IssueTypeScreenSchemeScheme
+	for _, v := range page.Values {
+		if v != nil && v.ID == id {
+			scheme = v
+		}
+	}
+	if scheme == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("issue type screen scheme %s not found", id)
+	}
+
+	api := &issueTypeScreenSchemeAPIModel{IssueTypeScreenSchemeScheme: scheme, WorkTypeScreenSchemes: map[string]string{}}
+	for startAt := 0; ; startAt += issueTypeScreenSchemePageSize {
+		items, itemsRS, err := r.schemeService.Mapping(ctx, []int{i}, startAt, issueTypeScreenSchemePageSize)
+		if err != nil {
+			return nil, itemsRS, err
+		}
+		for _, item := range items.Values {
+			if item == nil || item.IssueTypeScreenSchemeID != id {
+				continue
+			}
+			if item.IssueTypeID == issueTypeScreenSchemeDefaultMapping {
+				api.DefaultScreenSchemeID = item.ScreenSchemeID
+				continue
+			}
+			api.WorkTypeScreenSchemes[item.IssueTypeID] = item.ScreenSchemeID
+		}
+		if items.IsLast || len(items.Values) == 0 {
+			break
+		}
+	}
+	return api, rs, nil
+}
+
+// updateScheme updates the metadata and default mapping, then reconciles the work type mappings. Jira cannot
+// change a mapping in place, so changed mappings are removed and appended again.
+func (r *issueTypeScreenSchemeResource) updateScheme(ctx context.Context, id string, p *models.IssueTypeScreenSchemePayloadScheme) (*issueTypeScreenSchemeAPIModel, *models.ResponseScheme, error) {
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.schemeService.Update(ctx, id, p.Name, p.Description); err != nil {
+		return nil, rs, err
+	}
+
+	defaultID, planned := payloadMappings(p)
+	if defaultID != current.DefaultScreenSchemeID {
+		if rs, err := r.schemeService.UpdateDefault(ctx, id, defaultID); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	var toRemove []string
+	for wt, ss := range current.WorkTypeScreenSchemes {
+		if planned[wt] != ss {
+			toRemove = append(toRemove, wt)
+		}
+	}
+	if len(toRemove) > 0 {
+		sort.Strings(toRemove)
+		if rs, err := r.schemeService.Remove(ctx, id, toRemove); err != nil {
+			return nil, rs, err
+		}
+	}
+
+	var toAppend []*models.IssueTypeScreenSchemeMappingPayloadScheme
+	for wt, ss := range planned {
+		if current.WorkTypeScreenSchemes[wt] != ss {
+			toAppend = append(toAppend, &models.IssueTypeScreenSchemeMappingPayloadScheme{IssueTypeID: wt, ScreenSchemeID: ss})
+		}
+	}
+	if len(toAppend) > 0 {
+		sort.Slice(toAppend, func(a, b int) bool { return toAppend[a].IssueTypeID < toAppend[b].IssueTypeID })
+		payload := &models.IssueTypeScreenSchemePayloadScheme{IssueTypeMappings: toAppend}
+		if rs, err := r.schemeService.Append(ctx, id, payload); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *issueTypeScreenSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return r.schemeService.Delete(ctx, id)
+}
+
+// projects adapts the issue type screen scheme service for the shared project assignment helpers.
+func (r *issueTypeScreenSchemeResource) projects() schemeProjectAssignment {
+	return schemeProjectAssignment{
+		pageSize: issueTypeScreenSchemePageSize,
+		list: func(ctx context.Context, projectIDs []int, startAt, maxResults int) ([]schemeProjectAssociation, bool, *models.ResponseScheme, error) {
+			page, rs, err := r.schemeService.Projects(ctx, projectIDs, startAt, maxResults)
+			if err != nil {
+				return nil, false, rs, err
+			}
+			associations := make([]schemeProjectAssociation, 0, len(page.Values))
+			for _, v := range page.Values {
+				if v != nil && v.IssueTypeScreenScheme != nil {
+					associations = append(associations, schemeProjectAssociation{SchemeID: v.IssueTypeScreenScheme.ID, ProjectIDs: v.ProjectIDs})
+				}
+			}
+			return associations, page.IsLast, rs, nil
+		},
+		assign: r.schemeService.Assign,
+		defaultSchemeID: func(context.Context) (string, *models.ResponseScheme, error) {
+			return defaultIssueTypeScreenSchemeID, &models.ResponseScheme{Code: http.StatusOK}, nil
+		},
+	}
+}
+
+// syncProjects returns a post hook that records which managed projects use the scheme. With assign set, it
+// first assigns the planned projects that do not use the scheme yet.
+func (r *issueTypeScreenSchemeResource) syncProjects(assign bool) PostAPIHook[issueTypeScreenSchemeResourceModel, *issueTypeScreenSchemeAPIModel] {
+	return func(ctx context.Context, api *issueTypeScreenSchemeAPIModel, st *issueTypeScreenSchemeResourceModel) (*issueTypeScreenSchemeAPIModel, *models.ResponseScheme, error) {
+		using, rs, err := r.projects().syncProjects(ctx, api.ID, st.ProjectIDs, assign)
+		if err != nil {
+			return nil, rs, err
+		}
+		if using != nil {
+			api.ProjectIDs = using
+		}
+		return api, rs, nil
+	}
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *issueTypeScreenSchemeResource) hooks() CRUDHooks[issueTypeScreenSchemeResourceModel, *models.IssueTypeScreenSchemePayloadScheme, *issueTypeScreenSchemeAPIModel] {
+	return CRUDHooks[issueTypeScreenSchemeResourceModel, *models.IssueTypeScreenSchemePayloadScheme, *issueTypeScreenSchemeAPIModel]{
+		BuildPayload: func(ctx context.Context, st *issueTypeScreenSchemeResourceModel) (*models.IssueTypeScreenSchemePayloadScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			mappings := map[string]string{}
+			if !st.WorkTypeScreenSchemes.IsNull() && !st.WorkTypeScreenSchemes.IsUnknown() {
+				diags.Append(st.WorkTypeScreenSchemes.ElementsAs(ctx, &mappings, false)...)
+			}
+			// Jira requires the default mapping on create; it is listed first for a stable request body.
+			p := &models.IssueTypeScreenSchemePayloadScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+				IssueTypeMappings: []*models.IssueTypeScreenSchemeMappingPayloadScheme{
+					{IssueTypeID: issueTypeScreenSchemeDefaultMapping, ScreenSchemeID: st.DefaultScreenSchemeID.ValueString()},
+				},
+			}
+			workTypeIDs := make([]string, 0, len(mappings))
+			for wt := range mappings {
+				workTypeIDs = append(workTypeIDs, wt)
+			}
+			sort.Strings(workTypeIDs)
+			for _, wt := range workTypeIDs {
+				p.IssueTypeMappings = append(p.IssueTypeMappings, &models.IssueTypeScreenSchemeMappingPayloadScheme{
+					IssueTypeID:    wt,
+					ScreenSchemeID: mappings[wt],
+				})
+			}
+			return p, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *issueTypeScreenSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapIssueTypeScreenSchemeToModel,
+		PostCreate:              r.syncProjects(true),
+		PostRead:                r.syncProjects(false),
+		PostUpdate:              r.syncProjects(true),
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *issueTypeScreenSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeScreenSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeScreenSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	var state, plan issueTypeScreenSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Projects dropped from project_ids move back to the Default Issue Type Screen Scheme.
+	rs, err := r.projects().releaseProjects(ctx, state.ID.ValueString(), state.ProjectIDs, plan.ProjectIDs)
+	if !ensureWith(&resp.Diagnostics)(ctx, "release projects from issue type screen scheme", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeScreenSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	// Jira refuses to delete a scheme that projects still use, so managed projects are released first.
+	var state issueTypeScreenSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rs, err := r.projects().releaseProjects(ctx, state.ID.ValueString(), state.ProjectIDs, types.SetNull(types.StringType))
+	if !ensureWith(&resp.Diagnostics)(ctx, "release projects from issue type screen scheme", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *issueTypeScreenSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
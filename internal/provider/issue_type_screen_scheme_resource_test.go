@@ -0,0 +1,51 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIssueTypeScreenSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_issue_type_screen_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixScreenScheme)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetScreenSchemeCfg(t, testhelpers.ScreenSchemeTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("default_screen_scheme_id"), "jira_screen_scheme.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_screen_schemes"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("project_ids"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetScreenSchemeCfg(t, testhelpers.ScreenSchemeTmplCfg{Name: name, WorkTypeName: workTypeName, SplitScreens: true}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_screen_schemes"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Kinds of members handled by membershipReconciler.
+const (
+	memberKindUsers  = "users"
+	memberKindGroups = "groups"
+)
+
+// membersByKind holds member identifiers (account IDs, group names) by kind of member.
+type membersByKind map[string][]string
+
+// membershipReconciler manages the members of a group (jira_group_membership) or a project role
+// (jira_project_role_actors) in additive or authoritative mode. The callbacks adapt the endpoints of the target.
+type membershipReconciler struct {
+	// list returns every current member of the target.
+	list func(ctx context.Context) (membersByKind, *models.ResponseScheme, error)
+	// add adds the given members to the target.
+	add func(ctx context.Context, members membersByKind) (*models.ResponseScheme, error)
+	// remove removes one member of the given kind from the target.
+	remove func(ctx context.Context, kind, member string) (*models.ResponseScheme, error)
+}
+
+// update adds the planned members missing from the target and, in authoritative mode, removes every other member.
+// Removing managed members in additive mode happens before the update, where the prior state is known.
+func (m membershipReconciler) update(ctx context.Context, planned membersByKind, mode string) (*models.ResponseScheme, error) {
+	current, rs, err := m.list(ctx)
+	if err != nil {
+		return rs, err
+	}
+	missing, extra := membersByKind{}, membersByKind{}
+	for kind, want := range planned {
+		missing[kind], extra[kind] = diffStrings(current[kind], want)
+	}
+	if missing.count() > 0 {
+		if rs, err := m.add(ctx, missing); err != nil {
+			return rs, err
+		}
+	}
+	if mode == membershipModeAuthoritative {
+		return m.removeMembers(ctx, extra)
+	}
+	return &models.ResponseScheme{Code: http.StatusOK}, nil
+}
+
+// removeMembers removes members from the target; members that are already gone are skipped.
+func (m membershipReconciler) removeMembers(ctx context.Context, members membersByKind) (*models.ResponseScheme, error) {
+	for _, kind := range members.kinds() {
+		for _, member := range members[kind] {
+			rs, err := m.remove(ctx, kind, member)
+			if err != nil && (rs == nil || rs.Code != http.StatusNotFound) {
+				return rs, err
+			}
+		}
+	}
+	return &models.ResponseScheme{Code: http.StatusNoContent}, nil
+}
+
+// removeManaged removes the members of prior that are not in planned; with nil planned it removes all of them. In
+// authoritative mode prior holds every member of the target as of the last refresh.
+func (m membershipReconciler) removeManaged(ctx context.Context, prior, planned membersByKind) (*models.ResponseScheme, error) {
+	gone := membersByKind{}
+	for kind, had := range prior {
+		_, gone[kind] = diffStrings(had, planned[kind])
+	}
+	return m.removeMembers(ctx, gone)
+}
+
+// removesManagedOnUpdate reports whether an update from stateMode to planMode removes the managed members dropped
+// from the configuration. Switching from authoritative to additive keeps every current member: the prior state
+// lists members that were added in Jira, and those must not be removed.
+func removesManagedOnUpdate(stateMode, planMode string) bool {
+	return stateMode == planMode || planMode == membershipModeAuthoritative
+}
+
+// narrowManaged narrows the members read from Jira to the members in state when membership is additive, so members
+// added outside Terraform never show up as drift.
+func narrowManaged(mode string, current, state membersByKind) membersByKind {
+	if mode == membershipModeAuthoritative {
+		return current
+	}
+	out := membersByKind{}
+	for kind, members := range current {
+		out[kind] = intersectStrings(members, state[kind])
+	}
+	return out
+}
+
+// count returns the number of members of every kind.
+func (m membersByKind) count() int {
+	n := 0
+	for _, members := range m {
+		n += len(members)
+	}
+	return n
+}
+
+// kinds returns the kinds of member in a stable order.
+func (m membersByKind) kinds() []string {
+	kinds := make([]string, 0, len(m))
+	for kind := range m {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// setStrings returns the known string elements of a set; a null or unknown set yields none.
+func setStrings(ctx context.Context, s types.Set, diags *diag.Diagnostics) []string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	var out []string
+	diags.Append(s.ElementsAs(ctx, &out, false)...)
+	return out
+}
+
+// intersectStrings returns the values of in that are also in keep, preserving the order of in.
+func intersectStrings(in, keep []string) []string {
+	var out []string
+	for _, v := range in {
+		if slices.Contains(keep, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// membersFromState reads members by kind from the sets of a resource model.
+func membersFromState(ctx context.Context, sets map[string]types.Set) (membersByKind, error) {
+	var diags diag.Diagnostics
+	members := membersByKind{}
+	for kind, s := range sets {
+		members[kind] = setStrings(ctx, s, &diags)
+	}
+	if diags.HasError() {
+		return nil, fmt.Errorf("read members from state: %v", diags.Errors())
+	}
+	return members, nil
+}
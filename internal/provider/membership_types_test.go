@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// fakeMembership records the calls made by a membershipReconciler against an in-memory member list.
+type fakeMembership struct {
+	current membersByKind
+	added   membersByKind
+	removed []string
+}
+
+func (f *fakeMembership) reconciler() membershipReconciler {
+	return membershipReconciler{
+		list: func(context.Context) (membersByKind, *models.ResponseScheme, error) {
+			return f.current, &models.ResponseScheme{Code: http.StatusOK}, nil
+		},
+		add: func(_ context.Context, members membersByKind) (*models.ResponseScheme, error) {
+			f.added = members
+			return &models.ResponseScheme{Code: http.StatusCreated}, nil
+		},
+		remove: func(_ context.Context, kind, member string) (*models.ResponseScheme, error) {
+			f.removed = append(f.removed, kind+":"+member)
+			if member == "gone" {
+				return &models.ResponseScheme{Code: http.StatusNotFound}, errors.New("not found")
+			}
+			return &models.ResponseScheme{Code: http.StatusNoContent}, nil
+		},
+	}
+}
+
+func TestMembershipReconcilerUpdate(t *testing.T) {
+	planned := membersByKind{memberKindUsers: {"a", "b"}, memberKindGroups: {"g1"}}
+
+	f := &fakeMembership{current: membersByKind{memberKindUsers: {"a", "x"}, memberKindGroups: {"g2"}}}
+	if _, err := f.reconciler().update(context.Background(), planned, membershipModeAdditive); err != nil {
+		t.Fatalf("additive update: %v", err)
+	}
+	if !slices.Equal(f.added[memberKindUsers], []string{"b"}) || !slices.Equal(f.added[memberKindGroups], []string{"g1"}) {
+		t.Fatalf("unexpected additions: %v", f.added)
+	}
+	if len(f.removed) != 0 {
+		t.Fatalf("additive update removed members: %v", f.removed)
+	}
+
+	f = &fakeMembership{current: membersByKind{memberKindUsers: {"a", "b", "x"}, memberKindGroups: {"g1", "g2"}}}
+	if _, err := f.reconciler().update(context.Background(), planned, membershipModeAuthoritative); err != nil {
+		t.Fatalf("authoritative update: %v", err)
+	}
+	if f.added != nil {
+		t.Fatalf("unexpected additions: %v", f.added)
+	}
+	if want := []string{"groups:g2", "users:x"}; !slices.Equal(f.removed, want) {
+		t.Fatalf("removed %v, want %v", f.removed, want)
+	}
+}
+
+func TestMembershipReconcilerRemoveManaged(t *testing.T) {
+	f := &fakeMembership{}
+	prior := membersByKind{memberKindUsers: {"a", "gone", "b"}}
+	rs, err := f.reconciler().removeManaged(context.Background(), prior, membersByKind{memberKindUsers: {"b"}})
+	if err != nil || !IsSuccess(rs.Code) {
+		t.Fatalf("removeManaged: rs=%v err=%v", rs, err)
+	}
+	if want := []string{"users:a", "users:gone"}; !slices.Equal(f.removed, want) {
+		t.Fatalf("removed %v, want %v", f.removed, want)
+	}
+}
+
+func TestNarrowManaged(t *testing.T) {
+	current := membersByKind{memberKindUsers: {"a", "x"}}
+	state := membersByKind{memberKindUsers: {"a", "b"}}
+	if got := narrowManaged(membershipModeAdditive, current, state)[memberKindUsers]; !slices.Equal(got, []string{"a"}) {
+		t.Fatalf("additive: got %v", got)
+	}
+	if got := narrowManaged(membershipModeAuthoritative, current, state)[memberKindUsers]; !slices.Equal(got, []string{"a", "x"}) {
+		t.Fatalf("authoritative: got %v", got)
+	}
+	if removesManagedOnUpdate(membershipModeAuthoritative, membershipModeAdditive) {
+		t.Fatal("switching to additive must keep current members")
+	}
+}
@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = (*notificationSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*notificationSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*notificationSchemeResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*notificationSchemeResource)(nil)
+
+// NewNotificationSchemeResource returns the Terraform resource implementation for jira_notification_scheme.
+func NewNotificationSchemeResource() resource.Resource { return &notificationSchemeResource{} }
+
+type notificationSchemeResource struct {
+	ServiceClient
+	schemeService jira.NotificationSchemeConnector
+	crudRunner    CRUDRunner[notificationSchemeResourceModel, *models.NotificationSchemePayloadScheme, *models.NotificationSchemeScheme]
+}
+
+func (r *notificationSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_scheme"
+}
+
+func (r *notificationSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.schemeService = provider.client.NotificationScheme
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *notificationSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recipientTypes := make([]string, 0, len(notificationRecipientTypes))
+	for t := range notificationRecipientTypes {
+		recipientTypes = append(recipientTypes, t)
+	}
+	sort.Strings(recipientTypes)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira notification scheme and the recipients notified for each event. Assign it to a project with `notification_scheme_id` on `jira_project`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the notification scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the notification scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the notification scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(4000)},
+			},
+			"events": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The recipients notified for each event, keyed by event ID (for example `1` for issue created or `2` for issue updated). Events absent from the map notify no one.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric event ID")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"recipients": schema.SetNestedAttribute{
+							Required:            true,
+							MarkdownDescription: "The recipients of the event. Recipients are matched by type and parameter, so adding or removing one recipient only adds or removes that notification.",
+							Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: fmt.Sprintf("The recipient type. One of: `%s`.", strings.Join(recipientTypes, "`, `")),
+										Validators:          []validator.String{stringvalidator.OneOf(recipientTypes...)},
+									},
+									"parameter": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "The recipient: the account ID for `User`, the group name for `Group`, the project role ID for `ProjectRole`, the email address for `EmailAddress`, and the custom field ID (for example a `jira_field` ID) for `UserCustomField` and `GroupCustomField`. Must be omitted for the other recipient types.",
+										Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that recipient parameters match their recipient types.
+func (r *notificationSchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg notificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || cfg.Events.IsNull() || cfg.Events.IsUnknown() {
+		return
+	}
+	var events map[string]notificationEventModel
+	resp.Diagnostics.Append(cfg.Events.ElementsAs(ctx, &events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for eventID, e := range events {
+		if e.Recipients.IsNull() || e.Recipients.IsUnknown() {
+			continue
+		}
+		// Each recipient is decoded from its set element so diagnostics point at the offending recipient.
+		for _, el := range e.Recipients.Elements() {
+			obj, ok := el.(types.Object)
+			if !ok || obj.IsNull() || obj.IsUnknown() {
+				continue
+			}
+			var n notificationRecipientModel
+			resp.Diagnostics.Append(obj.As(ctx, &n, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if n.Type.IsUnknown() || n.Parameter.IsUnknown() {
+				continue
+			}
+			recipientPath := path.Root("events").AtMapKey(eventID).AtName("recipients").AtSetValue(el)
+			needsParameter, known := notificationRecipientTypes[n.Type.ValueString()]
+			if !known {
+				continue
+			}
+			switch {
+			case needsParameter && n.Parameter.IsNull():
+				resp.Diagnostics.AddAttributeError(recipientPath, "Missing recipient parameter",
+					fmt.Sprintf("The %s recipient of event %s requires parameter.", n.Type.ValueString(), eventID))
+			case !needsParameter && !n.Parameter.IsNull():
+				resp.Diagnostics.AddAttributeError(recipientPath, "Unexpected recipient parameter",
+					fmt.Sprintf("The %s recipient of event %s does not take a parameter.", n.Type.ValueString(), eventID))
+			}
+		}
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *notificationSchemeResource) createScheme(ctx context.Context, p *models.NotificationSchemePayloadScheme) (*models.NotificationSchemeScheme, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, created.ID)
+}
+
+func (r *notificationSchemeResource) getScheme(ctx context.Context, id string) (*models.NotificationSchemeScheme, *models.ResponseScheme, error) {
+	return r.schemeService.Get(ctx, id, []string{"all"})
+}
+
+// updateScheme updates the name and description, then adds the recipients missing from Jira and removes the ones
+// no longer configured. The update is sent directly because go-atlassian omits an empty description, which Jira
+// reads as unchanged.
+func (r *notificationSchemeResource) updateScheme(ctx context.Context, id string, p *models.NotificationSchemePayloadScheme) (*models.NotificationSchemeScheme, *models.ResponseScheme, error) {
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	body := map[string]string{"name": p.Name, "description": p.Description}
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/notificationscheme/%s", id), body, nil); err != nil {
+		return nil, rs, err
+	}
+
+	existing := make(map[string]int)
+	for _, e := range current.NotificationSchemeEvents {
+		if e == nil || e.Event == nil {
+			continue
+		}
+		for _, n := range e.Notifications {
+			if n != nil {
+				existing[notificationKey(strconv.Itoa(e.Event.ID), n.NotificationType, n.Parameter)] = n.ID
+			}
+		}
+	}
+	planned := make(map[string]struct{})
+	added := &models.NotificationSchemeEventsPayloadScheme{}
+	for _, e := range p.Events {
+		var missing []*models.NotificationSchemeEventNotificationScheme
+		for _, n := range e.Notifications {
+			key := notificationKey(e.Event.ID, n.NotificationType, n.Parameter)
+			planned[key] = struct{}{}
+			if _, ok := existing[key]; !ok {
+				missing = append(missing, n)
+			}
+		}
+		if len(missing) > 0 {
+			added.NotificationSchemeEvents = append(added.NotificationSchemeEvents, &models.NotificationSchemePayloadEventScheme{Event: e.Event, Notifications: missing})
+		}
+	}
+	if len(added.NotificationSchemeEvents) > 0 {
+		if rs, err := r.schemeService.Append(ctx, id, added); err != nil {
+			return nil, rs, err
+		}
+	}
+	for key, notificationID := range existing {
+		if _, ok := planned[key]; ok {
+			continue
+		}
+		if rs, err := r.schemeService.Remove(ctx, id, strconv.Itoa(notificationID)); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *notificationSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return r.schemeService.Delete(ctx, id)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *notificationSchemeResource) hooks() CRUDHooks[notificationSchemeResourceModel, *models.NotificationSchemePayloadScheme, *models.NotificationSchemeScheme] {
+	return CRUDHooks[notificationSchemeResourceModel, *models.NotificationSchemePayloadScheme, *models.NotificationSchemeScheme]{
+		BuildPayload: func(ctx context.Context, st *notificationSchemeResourceModel) (*models.NotificationSchemePayloadScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			p := &models.NotificationSchemePayloadScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}
+			var events map[string]notificationEventModel
+			if !st.Events.IsNull() && !st.Events.IsUnknown() {
+				diags.Append(st.Events.ElementsAs(ctx, &events, false)...)
+			}
+			eventIDs := make([]string, 0, len(events))
+			for eventID := range events {
+				eventIDs = append(eventIDs, eventID)
+			}
+			sort.Strings(eventIDs)
+			for _, eventID := range eventIDs {
+				var recipients []notificationRecipientModel
+				diags.Append(events[eventID].Recipients.ElementsAs(ctx, &recipients, false)...)
+				event := &models.NotificationSchemePayloadEventScheme{Event: &models.NotificationSchemeEventTypeScheme{ID: eventID}}
+				for _, n := range recipients {
+					event.Notifications = append(event.Notifications, &models.NotificationSchemeEventNotificationScheme{
+						NotificationType: n.Type.ValueString(),
+						Parameter:        n.Parameter.ValueString(),
+					})
+				}
+				p.Events = append(p.Events, event)
+			}
+			return p, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *notificationSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapNotificationSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *notificationSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *notificationSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *notificationSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *notificationSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *notificationSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *notificationSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *notificationSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *notificationSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *notificationSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *notificationSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *notificationSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *notificationSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *notificationSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNotificationSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_notification_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixNotification)
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetNotificationSchemeCfg(t, testhelpers.NotificationSchemeTmplCfg{Name: name}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(rName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("events"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("events").AtMapKey("1").AtMapKey("recipients"), knownvalue.SetSizeExact(3)),
+				},
+			},
+			{
+				Config: testhelpers.GetNotificationSchemeCfg(t, testhelpers.NotificationSchemeTmplCfg{
+					Name:        name,
+					Description: "Updated notification scheme description",
+					Updated:     true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(rName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated notification scheme description")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("events"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("events").AtMapKey("1").AtMapKey("recipients"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"type":      knownvalue.StringExact("UserCustomField"),
+							"parameter": knownvalue.StringRegexp(regexp.MustCompile(`^customfield_\d+$`)),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testhelpers.GetNotificationSchemeCfg(t, testhelpers.NotificationSchemeTmplCfg{Name: name}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(rName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("events"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
+
+func TestAccNotificationSchemeResource_missingParameter(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+resource "jira_notification_scheme" "test" {
+  name = "` + acctest.RandomWithPrefix(accPrefixNotification) + `"
+  events = {
+    "1" = {
+      recipients = [{ type = "Group" }]
+    }
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg,
+				ExpectError: regexp.MustCompile(`requires parameter`),
+			},
+		},
+	})
+}
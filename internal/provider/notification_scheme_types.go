@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationRecipientTypes lists the recipient types a notification scheme accepts, mapped to whether the
+// recipient requires a parameter (an account ID, group name, project role ID, email address or custom field ID).
+var notificationRecipientTypes = map[string]bool{
+	"AllWatchers":      false,
+	"ComponentLead":    false,
+	"CurrentAssignee":  false,
+	"CurrentUser":      false,
+	"EmailAddress":     true,
+	"Group":            true,
+	"GroupCustomField": true,
+	"ProjectLead":      false,
+	"ProjectRole":      true,
+	"Reporter":         false,
+	"User":             true,
+	"UserCustomField":  true,
+}
+
+// notificationSchemeResourceModel models the Terraform schema/state for jira_notification_scheme.
+type notificationSchemeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Events      types.Map    `tfsdk:"events"`
+}
+
+// notificationEventModel models a single value of the events map, keyed by event ID.
+type notificationEventModel struct {
+	Recipients types.Set `tfsdk:"recipients"`
+}
+
+// notificationRecipientModel models a single element of an event's recipients set.
+type notificationRecipientModel struct {
+	Type      types.String `tfsdk:"type"`
+	Parameter types.String `tfsdk:"parameter"`
+}
+
+func (m *notificationRecipientModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":      types.StringType,
+		"parameter": types.StringType,
+	}
+}
+
+func (m *notificationEventModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"recipients": types.SetType{ElemType: types.ObjectType{AttrTypes: (&notificationRecipientModel{}).AttributeTypes()}},
+	}
+}
+
+func (m *notificationSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"events":      types.MapType{ElemType: types.ObjectType{AttrTypes: (&notificationEventModel{}).AttributeTypes()}},
+	}
+}
+
+// notificationKey identifies a recipient of an event, which is how notifications are matched between
+// configuration and Jira.
+func notificationKey(eventID, notificationType, parameter string) string {
+	return eventID + "|" + notificationType + "|" + parameter
+}
+
+// mapNotificationSchemeToModel centralizes mapping for the notification scheme resource and matches CRUDHooks MapToState signature.
+func mapNotificationSchemeToModel(ctx context.Context, api *models.NotificationSchemeScheme, st *notificationSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no notification scheme payload to map into state.")
+		return diags
+	}
+
+	recipientType := types.ObjectType{AttrTypes: (&notificationRecipientModel{}).AttributeTypes()}
+	eventType := types.ObjectType{AttrTypes: (&notificationEventModel{}).AttributeTypes()}
+	events := make(map[string]notificationEventModel, len(api.NotificationSchemeEvents))
+	for _, e := range api.NotificationSchemeEvents {
+		if e == nil || e.Event == nil || len(e.Notifications) == 0 {
+			continue
+		}
+		recipients := make([]notificationRecipientModel, 0, len(e.Notifications))
+		for _, n := range e.Notifications {
+			if n == nil {
+				continue
+			}
+			recipients = append(recipients, notificationRecipientModel{
+				Type:      types.StringValue(n.NotificationType),
+				Parameter: stringOrNull(n.Parameter),
+			})
+		}
+		set, d := types.SetValueFrom(ctx, recipientType, recipients)
+		diags.Append(d...)
+		events[strconv.Itoa(e.Event.ID)] = notificationEventModel{Recipients: set}
+	}
+	if diags.HasError() {
+		return diags
+	}
+	eventMap := types.MapNull(eventType)
+	if len(events) > 0 {
+		var d diag.Diagnostics
+		eventMap, d = types.MapValueFrom(ctx, eventType, events)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*st = notificationSchemeResourceModel{
+		ID:          types.StringValue(strconv.Itoa(api.ID)),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+		Events:      eventMap,
+	}
+	return diags
+}
@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*permissionSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*permissionSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*permissionSchemeResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*permissionSchemeResource)(nil)
+
+// NewPermissionSchemeResource returns the Terraform resource implementation for jira_permission_scheme.
+func NewPermissionSchemeResource() resource.Resource { return &permissionSchemeResource{} }
+
+type permissionSchemeResource struct {
+	ServiceClient
+	permissionService jira.PermissionConnector
+	schemeService     jira.PermissionSchemeConnector
+	grantService      jira.PermissionSchemeGrantConnector
+	crudRunner        CRUDRunner[permissionSchemeResourceModel, *models.PermissionSchemeScheme, *models.PermissionSchemeScheme]
+}
+
+func (r *permissionSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_scheme"
+}
+
+func (r *permissionSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.permissionService = provider.client.Permission
+	r.schemeService = provider.client.Permission.Scheme
+	r.grantService = provider.client.Permission.Scheme.Grant
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *permissionSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	holderTypes := make([]string, 0, len(permissionHolderTypes))
+	for t := range permissionHolderTypes {
+		holderTypes = append(holderTypes, t)
+	}
+	sort.Strings(holderTypes)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira permission scheme and its grants. Assign it to a project with `permission_scheme_id` on `jira_project`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the permission scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the permission scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the permission scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(4000)},
+			},
+			"grants": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The permission grants of the scheme. Grants are matched by permission and holder, so adding or removing one grant only creates or deletes that grant.",
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The permission key, for example `BROWSE_PROJECTS`. The `jira_permissions` data source lists the valid keys.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"holder_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: fmt.Sprintf("Who the permission is granted to. One of: `%s`.", strings.Join(holderTypes, "`, `")),
+							Validators:          []validator.String{stringvalidator.OneOf(holderTypes...)},
+						},
+						"holder_parameter": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The holder: the group name for `group`, the project role ID for `projectRole`, the account ID for `user`, the custom field ID for `groupCustomField` and `userCustomField`, and optionally the application key for `applicationRole`. Must be omitted for the other holder types.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that holder parameters match their holder types and, once the provider is configured,
+// that every permission key exists in Jira, so mistakes surface at plan time instead of at apply.
+func (r *permissionSchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg permissionSchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || cfg.Grants.IsNull() || cfg.Grants.IsUnknown() {
+		return
+	}
+	// Each grant is kept with its set element so diagnostics point at the offending grant.
+	type configGrant struct {
+		permissionGrantModel
+		attrPath path.Path
+	}
+	grants := make([]configGrant, 0, len(cfg.Grants.Elements()))
+	for _, el := range cfg.Grants.Elements() {
+		obj, ok := el.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		var g permissionGrantModel
+		resp.Diagnostics.Append(obj.As(ctx, &g, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		grants = append(grants, configGrant{permissionGrantModel: g, attrPath: path.Root("grants").AtSetValue(el)})
+	}
+
+	for _, g := range grants {
+		if g.HolderType.IsUnknown() || g.HolderParameter.IsUnknown() {
+			continue
+		}
+		needsParameter, known := permissionHolderTypes[g.HolderType.ValueString()]
+		if !known {
+			continue
+		}
+		holderType := g.HolderType.ValueString()
+		switch {
+		case needsParameter && g.HolderParameter.IsNull():
+			resp.Diagnostics.AddAttributeError(g.attrPath.AtName("holder_parameter"), "Missing holder parameter",
+				fmt.Sprintf("The %s grant of %q requires holder_parameter.", g.Permission.ValueString(), holderType))
+		case !needsParameter && holderType != "applicationRole" && !g.HolderParameter.IsNull():
+			resp.Diagnostics.AddAttributeError(g.attrPath.AtName("holder_parameter"), "Unexpected holder parameter",
+				fmt.Sprintf("The %s grant of %q does not take a holder_parameter.", g.Permission.ValueString(), holderType))
+		}
+	}
+
+	// The provider is not configured during `terraform validate`; the keys are checked again at plan time.
+	if r.permissionService == nil {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	// The key check is best effort: a failed lookup is logged and skipped, and Jira reports unknown keys on apply.
+	permissions, rs, err := r.permissionService.Gets(ctx)
+	if err != nil || !IsSuccess(HTTPStatusFromScheme(rs)) {
+		tflog.Debug(ctx, "skipping permission key check at plan time", map[string]interface{}{"status": HTTPStatusFromScheme(rs)})
+		return
+	}
+	keys := make(map[string]struct{}, len(permissions))
+	for _, p := range permissions {
+		if p != nil {
+			keys[p.Key] = struct{}{}
+		}
+	}
+	for _, g := range grants {
+		if g.Permission.IsUnknown() {
+			continue
+		}
+		if _, ok := keys[g.Permission.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(g.attrPath.AtName("permission"), "Unknown permission key",
+				fmt.Sprintf("%q is not a permission in this Jira site. See the jira_permissions data source for the valid keys.", g.Permission.ValueString()))
+		}
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *permissionSchemeResource) createScheme(ctx context.Context, p *models.PermissionSchemeScheme) (*models.PermissionSchemeScheme, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, strconv.Itoa(created.ID))
+}
+
+func (r *permissionSchemeResource) getScheme(ctx context.Context, id string) (*models.PermissionSchemeScheme, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid permission scheme id %q: %w", id, err)
+	}
+	return r.schemeService.Get(ctx, i, []string{"permissions"})
+}
+
+// updateScheme updates the name and description, then creates the grants missing from Jira and deletes the ones
+// no longer configured. Sending the grants with the update would replace every grant of the scheme.
+func (r *permissionSchemeResource) updateScheme(ctx context.Context, id string, p *models.PermissionSchemeScheme) (*models.PermissionSchemeScheme, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid permission scheme id %q: %w", id, err)
+	}
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	if _, rs, err := r.schemeService.Update(ctx, i, &models.PermissionSchemeScheme{Name: p.Name, Description: p.Description}); err != nil {
+		return nil, rs, err
+	}
+
+	existing := make(map[string]*models.PermissionGrantScheme, len(current.Permissions))
+	for _, g := range current.Permissions {
+		if g != nil {
+			existing[permissionGrantKey(g)] = g
+		}
+	}
+	planned := make(map[string]struct{}, len(p.Permissions))
+	for _, g := range p.Permissions {
+		key := permissionGrantKey(g)
+		planned[key] = struct{}{}
+		if _, ok := existing[key]; ok {
+			continue
+		}
+		if _, rs, err := r.grantService.Create(ctx, i, &models.PermissionGrantPayloadScheme{Holder: g.Holder, Permission: g.Permission}); err != nil {
+			return nil, rs, err
+		}
+	}
+	for key, g := range existing {
+		if _, ok := planned[key]; ok {
+			continue
+		}
+		if rs, err := r.grantService.Delete(ctx, i, g.ID); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *permissionSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permission scheme id %q: %w", id, err)
+	}
+	return r.schemeService.Delete(ctx, i)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *permissionSchemeResource) hooks() CRUDHooks[permissionSchemeResourceModel, *models.PermissionSchemeScheme, *models.PermissionSchemeScheme] {
+	return CRUDHooks[permissionSchemeResourceModel, *models.PermissionSchemeScheme, *models.PermissionSchemeScheme]{
+		BuildPayload: func(ctx context.Context, st *permissionSchemeResourceModel) (*models.PermissionSchemeScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			p := &models.PermissionSchemeScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}
+			var grants []permissionGrantModel
+			if !st.Grants.IsNull() && !st.Grants.IsUnknown() {
+				diags.Append(st.Grants.ElementsAs(ctx, &grants, false)...)
+			}
+			for _, g := range grants {
+				p.Permissions = append(p.Permissions, &models.PermissionGrantScheme{
+					Permission: g.Permission.ValueString(),
+					Holder: &models.PermissionGrantHolderScheme{
+						Type:      g.HolderType.ValueString(),
+						Parameter: g.HolderParameter.ValueString(),
+					},
+				})
+			}
+			return p, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *permissionSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapPermissionSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *permissionSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *permissionSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *permissionSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *permissionSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *permissionSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *permissionSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *permissionSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *permissionSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *permissionSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
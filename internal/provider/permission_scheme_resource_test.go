@@ -0,0 +1,91 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPermissionSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_permission_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixPermission)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetPermissionSchemeCfg(t, testhelpers.PermissionSchemeTmplCfg{Name: name}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("grants"), knownvalue.SetSizeExact(2)),
+				},
+			},
+			{
+				Config: testhelpers.GetPermissionSchemeCfg(t, testhelpers.PermissionSchemeTmplCfg{
+					Name:        name,
+					Description: "Updated permission scheme description",
+					ExtraGrant:  true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated permission scheme description")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("grants"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"permission":       knownvalue.StringExact("ADMINISTER_PROJECTS"),
+							"holder_type":      knownvalue.StringExact("applicationRole"),
+							"holder_parameter": knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testhelpers.GetPermissionSchemeCfg(t, testhelpers.PermissionSchemeTmplCfg{Name: name}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("grants"), knownvalue.SetSizeExact(2)),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
+
+func TestAccPermissionSchemeResource_invalidGrant(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+resource "jira_permission_scheme" "test" {
+  name = "` + acctest.RandomWithPrefix(accPrefixPermission) + `"
+  grants = [
+    {
+      permission  = "BROWSE_PROJECTS"
+      holder_type = "group"
+    },
+  ]
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg,
+				ExpectError: regexp.MustCompile(`requires holder_parameter`),
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// permissionHolderTypes lists the grant holder types a permission scheme accepts, mapped to whether the holder
+// requires a parameter (a group, project role ID, account ID or custom field ID). The parameter of an
+// applicationRole holder is optional: without one the grant applies to any application role.
+var permissionHolderTypes = map[string]bool{
+	"anyone":                  false,
+	"applicationRole":         false,
+	"assignee":                false,
+	"group":                   true,
+	"groupCustomField":        true,
+	"projectLead":             false,
+	"projectRole":             true,
+	"reporter":                false,
+	"sd.customer.portal.only": false,
+	"user":                    true,
+	"userCustomField":         true,
+}
+
+// permissionSchemeResourceModel models the Terraform schema/state for jira_permission_scheme.
+type permissionSchemeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Grants      types.Set    `tfsdk:"grants"`
+}
+
+// permissionGrantModel models a single element of the grants set.
+type permissionGrantModel struct {
+	Permission      types.String `tfsdk:"permission"`
+	HolderType      types.String `tfsdk:"holder_type"`
+	HolderParameter types.String `tfsdk:"holder_parameter"`
+}
+
+func (m *permissionGrantModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"permission":       types.StringType,
+		"holder_type":      types.StringType,
+		"holder_parameter": types.StringType,
+	}
+}
+
+func (m *permissionSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"grants":      types.SetType{ElemType: types.ObjectType{AttrTypes: (&permissionGrantModel{}).AttributeTypes()}},
+	}
+}
+
+// permissionGrantKey identifies a grant by its permission and holder, which is how grants are matched between
+// configuration and Jira; grant IDs are not exposed.
+func permissionGrantKey(g *models.PermissionGrantScheme) string {
+	if g.Holder == nil {
+		return g.Permission + "|"
+	}
+	return g.Permission + "|" + g.Holder.Type + "|" + g.Holder.Parameter
+}
+
+// mapPermissionSchemeToModel centralizes mapping for the permission scheme resource and matches CRUDHooks MapToState signature.
+func mapPermissionSchemeToModel(ctx context.Context, api *models.PermissionSchemeScheme, st *permissionSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no permission scheme payload to map into state.")
+		return diags
+	}
+
+	grantType := types.ObjectType{AttrTypes: (&permissionGrantModel{}).AttributeTypes()}
+	grants := make([]permissionGrantModel, 0, len(api.Permissions))
+	for _, g := range api.Permissions {
+		if g == nil || g.Holder == nil {
+			continue
+		}
+		grants = append(grants, permissionGrantModel{
+			Permission:      types.StringValue(g.Permission),
+			HolderType:      types.StringValue(g.Holder.Type),
+			HolderParameter: stringOrNull(g.Holder.Parameter),
+		})
+	}
+	grantSet := types.SetNull(grantType)
+	if len(grants) > 0 {
+		var d diag.Diagnostics
+		grantSet, d = types.SetValueFrom(ctx, grantType, grants)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*st = permissionSchemeResourceModel{
+		ID:          types.StringValue(strconv.Itoa(api.ID)),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+		Grants:      grantSet,
+	}
+	return diags
+}
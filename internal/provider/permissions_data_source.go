@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*permissionsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*permissionsDataSource)(nil)
+
+// NewPermissionsDataSource returns the Terraform data source implementation for jira_permissions.
+func NewPermissionsDataSource() datasource.DataSource { return &permissionsDataSource{} }
+
+type permissionsDataSource struct {
+	ServiceClient
+	permissionService jira.PermissionConnector
+}
+
+type permissionsDataSourceModel struct {
+	Type        types.String `tfsdk:"type"`
+	Keys        types.List   `tfsdk:"keys"`
+	Permissions types.Map    `tfsdk:"permissions"`
+}
+
+// permissionModel models a single element of the permissions map.
+type permissionModel struct {
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *permissionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":         types.StringType,
+		"name":        types.StringType,
+		"type":        types.StringType,
+		"description": types.StringType,
+	}
+}
+
+func (d *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *permissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the permissions of the Jira site, including those added by apps. Use the keys as `permission` in `jira_permission_scheme` grants.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by permission type: `PROJECT` for permissions granted through permission schemes, or `GLOBAL`. If omitted, all permissions are returned.",
+				Validators:          []validator.String{stringvalidator.OneOf("PROJECT", "GLOBAL")},
+			},
+			"keys": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The permission keys, sorted alphabetically.",
+			},
+			"permissions": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of permissions keyed by permission key. Each value includes key, name, type, and description.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The permission key, for example `BROWSE_PROJECTS`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display name of the permission.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The permission type: `PROJECT` or `GLOBAL`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of the permission.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *permissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.permissionService = provider.client.Permission
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *permissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data permissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, apiResp, err := d.permissionService.Gets(ctx)
+	if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "list permissions", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return
+	}
+
+	keys := make([]string, 0, len(permissions))
+	out := make(map[string]permissionModel, len(permissions))
+	for _, p := range permissions {
+		if p == nil || (!data.Type.IsNull() && !strings.EqualFold(p.Type, data.Type.ValueString())) {
+			continue
+		}
+		keys = append(keys, p.Key)
+		out[p.Key] = permissionModel{
+			Key:         types.StringValue(p.Key),
+			Name:        types.StringValue(p.Name),
+			Type:        types.StringValue(p.Type),
+			Description: stringOrNull(p.Description),
+		}
+	}
+	// go-atlassian decodes the permissions from a JSON object, so they arrive in random order.
+	sort.Strings(keys)
+
+	var diags diag.Diagnostics
+	data.Keys, diags = types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	data.Permissions, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: (&permissionModel{}).AttributeTypes()}, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
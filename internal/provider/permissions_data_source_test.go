@@ -0,0 +1,32 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPermissionsDataSource_basic(t *testing.T) {
+	t.Parallel()
+	rName := "data.jira_permissions.project"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jira_permissions" "project" { type = "PROJECT" }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("keys"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("permissions").AtMapKey("BROWSE_PROJECTS").AtMapKey("type"), knownvalue.StringExact("PROJECT")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*prioritiesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*prioritiesDataSource)(nil)
+
+// prioritiesPageSize is the page size used when paging through the priority search endpoint.
+const prioritiesPageSize = 50
+
+// NewPrioritiesDataSource returns the Terraform data source implementation for jira_priorities.
+func NewPrioritiesDataSource() datasource.DataSource { return &prioritiesDataSource{} }
+
+type prioritiesDataSource struct {
+	ServiceClient
+}
+
+type prioritiesDataSourceModel struct {
+	Priorities types.List `tfsdk:"priorities"`
+}
+
+func (d *prioritiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priorities"
+}
+
+func (d *prioritiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all Jira priorities in the site-wide priority order, from highest to lowest.",
+		Attributes: map[string]schema.Attribute{
+			"priorities": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of priorities in priority order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the priority.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the priority.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the priority.",
+						},
+						"status_color": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The colour of the priority as a hex code.",
+						},
+						"icon_url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL of the priority icon.",
+						},
+						"is_default": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the priority is the site default.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *prioritiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *prioritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data prioritiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list keeps Jira's order, so the map-based list helpers do not apply here.
+	priorities := []priorityResourceModel{}
+	for startAt := 0; ; startAt += prioritiesPageSize {
+		var page priorityPage
+		query := url.Values{"startAt": {strconv.Itoa(startAt)}, "maxResults": {strconv.Itoa(prioritiesPageSize)}}.Encode()
+		apiResp, err := callJira(ctx, d.client, http.MethodGet, "rest/api/3/priority/search?"+query, nil, &page)
+		if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "search priorities", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+		for _, p := range page.Values {
+			if p == nil {
+				continue
+			}
+			var m priorityResourceModel
+			resp.Diagnostics.Append(mapPriorityToModel(ctx, p, &m)...)
+			priorities = append(priorities, m)
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, lDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&priorityResourceModel{}).AttributeTypes()}, priorities)
+	if lDiags.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("priorities"),
+			"Failed to build priorities list",
+			fmt.Sprintf("Could not encode %d priorities into state. See diagnostics for details.", len(priorities)),
+		)
+		resp.Diagnostics.Append(lDiags...)
+		return
+	}
+	data.Priorities = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*priorityResource)(nil)
+var _ resource.ResourceWithConfigure = (*priorityResource)(nil)
+var _ resource.ResourceWithImportState = (*priorityResource)(nil)
+
+// NewPriorityResource returns the Terraform resource implementation for jira_priority.
+func NewPriorityResource() resource.Resource { return &priorityResource{} }
+
+type priorityResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[priorityResourceModel, *priorityPayload, *priorityAPIModel]
+}
+
+func (r *priorityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priority"
+}
+
+func (r *priorityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *priorityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira priority. Group priorities for projects with `jira_priority_scheme`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the priority. Automatically generated by Jira when the priority is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the priority. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 60)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the priority.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"status_color": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The colour of the priority as a hex code, for example `#FF0000`.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(hexColorRegex, "must be a hex colour such as #F00 or #FF0000")},
+			},
+			"icon_url": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The URL of the priority icon. Built-in icons such as `/images/icons/priorities/major.svg` are accepted. If omitted, Jira assigns a default icon.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the priority is the site default, applied to new work items without a priority. Only one priority should set this. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the priority endpoints, which go-atlassian does not wrap for writes.
+func (r *priorityResource) createPriority(ctx context.Context, p *priorityPayload) (*priorityAPIModel, *models.ResponseScheme, error) {
+	var created priorityAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/priority", p, &created); err != nil {
+		return nil, rs, err
+	}
+	if p.IsDefault {
+		if rs, err := r.setDefault(ctx, created.ID); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getPriority(ctx, created.ID)
+}
+
+// getPriority reads the priority through the search endpoint, the only one that reports whether it is the default.
+func (r *priorityResource) getPriority(ctx context.Context, id string) (*priorityAPIModel, *models.ResponseScheme, error) {
+	var page priorityPage
+	rs, err := callJira(ctx, r.client, http.MethodGet, "rest/api/3/priority/search?"+url.Values{"id": {id}}.Encode(), nil, &page)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, p := range page.Values {
+		if p != nil && p.ID == id {
+			return p, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("priority %s not found", id)
+}
+
+// updatePriority updates the priority and moves the site default to or away from it.
+func (r *priorityResource) updatePriority(ctx context.Context, id string, p *priorityPayload) (*priorityAPIModel, *models.ResponseScheme, error) {
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/priority/%s", id), p, nil); err != nil {
+		return nil, rs, err
+	}
+	current, rs, err := r.getPriority(ctx, id)
+	if err != nil || current.IsDefault == p.IsDefault {
+		return current, rs, err
+	}
+	// The default is only cleared while this priority still holds it, so moving the default to another priority in
+	// the same apply is never undone. A null ID clears the site default.
+	var defaultID *string
+	if p.IsDefault {
+		defaultID = &id
+	}
+	if rs, err := r.setDefault(ctx, defaultID); err != nil {
+		return nil, rs, err
+	}
+	return r.getPriority(ctx, id)
+}
+
+// setDefault makes the priority the site default; nil clears the default.
+func (r *priorityResource) setDefault(ctx context.Context, id any) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodPut, "rest/api/3/priority/default", map[string]any{"id": id}, nil)
+}
+
+// deletePriority removes the priority. Jira removes priorities asynchronously and redirects to the task, which is
+// awaited so schemes and issues no longer reference the priority once the delete returns.
+func (r *priorityResource) deletePriority(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	var task models.TaskScheme
+	rs, err := callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/priority/%s", id), nil, &task)
+	if err != nil || task.ID == "" {
+		return rs, err
+	}
+	_, rs, err = waitForJiraTask(ctx, r.client, task.ID, "delete priority")
+	return rs, err
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *priorityResource) hooks() CRUDHooks[priorityResourceModel, *priorityPayload, *priorityAPIModel] {
+	return CRUDHooks[priorityResourceModel, *priorityPayload, *priorityAPIModel]{
+		BuildPayload: func(_ context.Context, st *priorityResourceModel) (*priorityPayload, diag.Diagnostics) {
+			p := &priorityPayload{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+				StatusColor: st.StatusColor.ValueString(),
+				IsDefault:   st.IsDefault.ValueBool(),
+			}
+			if !st.IconURL.IsUnknown() {
+				p.IconURL = st.IconURL.ValueString()
+			}
+			return p, nil
+		},
+		APICreate:               r.createPriority,
+		APIRead:                 r.getPriority,
+		APIUpdate:               r.updatePriority,
+		APIDelete:               r.deletePriority,
+		ExtractID:               func(st *priorityResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapPriorityToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *priorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *priorityResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *priorityResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *priorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *priorityResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *priorityResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *priorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *priorityResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *priorityResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *priorityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *priorityResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *priorityResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *priorityResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPriorityResource_basic(t *testing.T) {
+	t.Parallel()
+
+	highName := "jira_priority.high"
+	lowName := "jira_priority.low"
+	schemeName := "jira_priority_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixPriority)
+	sameHighID := statecheck.CompareValue(compare.ValuesSame())
+	sameSchemeID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetPriorityCfg(t, testhelpers.PriorityTmplCfg{Name: name}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(highName, tfjsonpath.New("name"), knownvalue.StringExact(name+"-high")),
+					statecheck.ExpectKnownValue(highName, tfjsonpath.New("status_color"), knownvalue.StringExact("#D04437")),
+					statecheck.ExpectKnownValue(highName, tfjsonpath.New("is_default"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(lowName, tfjsonpath.New("icon_url"), knownvalue.NotNull()),
+					sameHighID.AddStateValue(highName, tfjsonpath.New("id")),
+					sameSchemeID.AddStateValue(schemeName, tfjsonpath.New("id")),
+					statecheck.CompareValuePairs(schemeName, tfjsonpath.New("priority_ids").AtSliceIndex(0), highName, tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(schemeName, tfjsonpath.New("priority_ids").AtSliceIndex(1), lowName, tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(schemeName, tfjsonpath.New("default_priority_id"), highName, tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("data.jira_priorities.all", tfjsonpath.New("priorities"), knownvalue.NotNull()),
+				},
+			},
+			{
+				// Swapping the order moves the priorities in place; neither priority nor the scheme is replaced.
+				Config: testhelpers.GetPriorityCfg(t, testhelpers.PriorityTmplCfg{Name: name, Reversed: true}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameHighID.AddStateValue(highName, tfjsonpath.New("id")),
+					sameSchemeID.AddStateValue(schemeName, tfjsonpath.New("id")),
+					statecheck.CompareValuePairs(schemeName, tfjsonpath.New("priority_ids").AtSliceIndex(0), lowName, tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(schemeName, tfjsonpath.New("priority_ids").AtSliceIndex(1), highName, tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    highName,
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    schemeName,
+			},
+		},
+	})
+}
+
+func TestPlanPriorityMoves(t *testing.T) {
+	t.Parallel()
+
+	// apply replays the moves on have the way Jira's move endpoint would.
+	apply := func(have []string, moves []priorityMove) []string {
+		out := slices.Clone(have)
+		for _, m := range moves {
+			out = slices.DeleteFunc(out, func(id string) bool { return slices.Contains(m.IDs, id) })
+			at := 0
+			if m.After != "" {
+				at = slices.Index(out, m.After) + 1
+			}
+			out = slices.Insert(out, at, m.IDs...)
+		}
+		return out
+	}
+
+	cases := []struct {
+		name      string
+		have      []string
+		want      []string
+		wantMoves int
+	}{
+		{name: "in order", have: []string{"1", "2", "3"}, want: []string{"1", "2", "3"}, wantMoves: 0},
+		{name: "swap", have: []string{"1", "2"}, want: []string{"2", "1"}, wantMoves: 1},
+		{name: "last to first", have: []string{"2", "3", "4", "1"}, want: []string{"1", "2", "3", "4"}, wantMoves: 1},
+		{name: "first to last", have: []string{"4", "1", "2", "3"}, want: []string{"1", "2", "3", "4"}, wantMoves: 1},
+		{name: "adjacent run moves together", have: []string{"3", "4", "1", "2"}, want: []string{"1", "2", "3", "4"}, wantMoves: 1},
+		{name: "reverse", have: []string{"1", "2", "3", "4"}, want: []string{"4", "3", "2", "1"}, wantMoves: 1},
+		{name: "other priorities between", have: []string{"1", "9", "2", "8", "3"}, want: []string{"3", "1", "2"}, wantMoves: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			moves := planPriorityMoves(tc.have, tc.want)
+			if len(moves) != tc.wantMoves {
+				t.Errorf("got %d moves %+v, want %d", len(moves), moves, tc.wantMoves)
+			}
+			got := slices.DeleteFunc(apply(tc.have, moves), func(id string) bool { return !slices.Contains(tc.want, id) })
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("order after moves = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,425 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*prioritySchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*prioritySchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*prioritySchemeResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*prioritySchemeResource)(nil)
+
+// prioritySchemePageSize is the page size used when listing the priorities and projects of a priority scheme.
+const prioritySchemePageSize = 50
+
+// NewPrioritySchemeResource returns the Terraform resource implementation for jira_priority_scheme.
+func NewPrioritySchemeResource() resource.Resource { return &prioritySchemeResource{} }
+
+type prioritySchemeResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[prioritySchemeResourceModel, *prioritySchemePayload, *prioritySchemeAPIModel]
+}
+
+func (r *prioritySchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priority_scheme"
+}
+
+func (r *prioritySchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *prioritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira priority scheme, the ordered set of priorities available to the projects that use it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the priority scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the priority scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the priority scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(4000)},
+			},
+			"priority_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				MarkdownDescription: "Ordered list of priority IDs in the scheme (for example `jira_priority.example.id`), from highest to lowest. " +
+					"Jira orders priorities site-wide, so reordering moves the priorities in every scheme that contains them.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric priority ID")),
+				},
+			},
+			"default_priority_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the priority given to new work items in projects using the scheme. Must be one of `priority_ids`.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric priority ID")},
+			},
+			"project_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of the projects that use this scheme. When omitted, project assignment is not managed. Projects removed from the set are moved back to the default priority scheme.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")),
+				},
+			},
+		},
+	}
+}
+
+func (r *prioritySchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data prioritySchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DefaultPriorityID.IsNull() || data.DefaultPriorityID.IsUnknown() {
+		return
+	}
+	priorityIDs, deferEval := getKnownStrings(ctx, data.PriorityIDs, "priority_ids", &resp.Diagnostics)
+	if deferEval || resp.Diagnostics.HasError() {
+		return
+	}
+	if !slices.Contains(priorityIDs, data.DefaultPriorityID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_priority_id"),
+			"Default priority not in scheme",
+			fmt.Sprintf("The default priority %q must also be listed in 'priority_ids'.", data.DefaultPriorityID.ValueString()),
+		)
+	}
+}
+
+// Wrapper functions to adapt the priority scheme endpoints, which go-atlassian does not wrap.
+func (r *prioritySchemeResource) createScheme(ctx context.Context, p *prioritySchemePayload) (*prioritySchemeAPIModel, *models.ResponseScheme, error) {
+	body := map[string]any{
+		"name":              p.Name,
+		"defaultPriorityId": json.Number(p.DefaultPriorityID),
+		"priorityIds":       idNumbers(p.PriorityIDs),
+	}
+	if p.Description != "" {
+		body["description"] = p.Description
+	}
+	if len(p.ProjectIDs) > 0 {
+		body["projectIds"] = idNumbers(p.ProjectIDs)
+	}
+	var created prioritySchemeTaskResponse
+	if rs, err := r.writeScheme(ctx, http.MethodPost, "rest/api/3/priorityscheme", body, &created, "create priority scheme"); err != nil {
+		return nil, rs, err
+	}
+	return r.orderPriorities(ctx, created.ID.String(), p.PriorityIDs)
+}
+
+// getScheme reads the scheme together with its priorities in order and its projects. Jira has no single-scheme
+// endpoint, so a missing scheme is reported as a synthetic 404.
+func (r *prioritySchemeResource) getScheme(ctx context.Context, id string) (*prioritySchemeAPIModel, *models.ResponseScheme, error) {
+	var page prioritySchemePage
+	rs, err := callJira(ctx, r.client, http.MethodGet, "rest/api/3/priorityscheme?"+url.Values{"schemeId": {id}}.Encode(), nil, &page)
+	if err != nil {
+		return nil, rs, err
+	}
+	var scheme *prioritySchemeAPIModel
+	for _, v := range page.Values {
+		if v != nil && v.ID.String() == id {
+			scheme = v
+		}
+	}
+	if scheme == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("priority scheme %s not found", id)
+	}
+
+	scheme.PriorityIDs = []string{}
+	for startAt := 0; ; startAt += prioritySchemePageSize {
+		var priorities priorityPage
+		if rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/priorityscheme/%s/priorities?%s", id, prioritySchemePageQuery(startAt)), nil, &priorities); err != nil {
+			return nil, rs, err
+		}
+		for _, p := range priorities.Values {
+			if p == nil {
+				continue
+			}
+			scheme.PriorityIDs = append(scheme.PriorityIDs, p.ID)
+			// Older responses omit defaultPriorityId on the scheme and flag the default priority instead.
+			if scheme.DefaultPriorityID == "" && p.IsDefault {
+				scheme.DefaultPriorityID = json.Number(p.ID)
+			}
+		}
+		if priorities.IsLast || len(priorities.Values) == 0 {
+			break
+		}
+	}
+
+	for startAt := 0; ; startAt += prioritySchemePageSize {
+		var projects prioritySchemeProjectPage
+		if rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/priorityscheme/%s/projects?%s", id, prioritySchemePageQuery(startAt)), nil, &projects); err != nil {
+			return nil, rs, err
+		}
+		for _, p := range projects.Values {
+			if p != nil {
+				scheme.ProjectIDs = append(scheme.ProjectIDs, p.ID)
+			}
+		}
+		if projects.IsLast || len(projects.Values) == 0 {
+			break
+		}
+	}
+	return scheme, rs, nil
+}
+
+// updateScheme sends the metadata together with the priorities and projects to add and remove, then restores
+// the configured order. Projects are left alone when project_ids is not managed.
+func (r *prioritySchemeResource) updateScheme(ctx context.Context, id string, p *prioritySchemePayload) (*prioritySchemeAPIModel, *models.ResponseScheme, error) {
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+
+	body := map[string]any{
+		"name":              p.Name,
+		"description":       p.Description,
+		"defaultPriorityId": json.Number(p.DefaultPriorityID),
+	}
+	if added, removed := diffStrings(current.PriorityIDs, p.PriorityIDs); len(added) > 0 || len(removed) > 0 {
+		body["priorities"] = idChanges(added, removed)
+	}
+	if len(p.ProjectIDs) > 0 {
+		if added, removed := diffStrings(current.ProjectIDs, p.ProjectIDs); len(added) > 0 || len(removed) > 0 {
+			body["projects"] = idChanges(added, removed)
+		}
+	}
+	if rs, err := r.writeScheme(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/priorityscheme/%s", id), body, nil, "update priority scheme"); err != nil {
+		return nil, rs, err
+	}
+	return r.orderPriorities(ctx, id, p.PriorityIDs)
+}
+
+// deleteScheme moves the scheme's projects back to the default priority scheme first, because Jira only deletes
+// schemes that no project uses.
+func (r *prioritySchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil {
+		return rs, err
+	}
+	if len(current.ProjectIDs) > 0 {
+		body := map[string]any{"projects": idChanges(nil, current.ProjectIDs)}
+		if rs, err := r.writeScheme(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/priorityscheme/%s", id), body, nil, "release priority scheme projects"); err != nil {
+			return rs, err
+		}
+	}
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/priorityscheme/%s", id), nil, nil)
+}
+
+// writeScheme sends a priority scheme create or update and waits for the task Jira starts when projects join or
+// leave the scheme, which moves their work items to the new priorities.
+func (r *prioritySchemeResource) writeScheme(ctx context.Context, method, endpoint string, body any, out *prioritySchemeTaskResponse, action string) (*models.ResponseScheme, error) {
+	if out == nil {
+		out = &prioritySchemeTaskResponse{}
+	}
+	rs, err := callJira(ctx, r.client, method, endpoint, body, out)
+	if err != nil || out.Task == nil || out.Task.ID == "" {
+		return rs, err
+	}
+	_, rs, err = waitForJiraTask(ctx, r.client, out.Task.ID, action)
+	return rs, err
+}
+
+// orderPriorities moves the scheme's priorities into the order of want and returns the refreshed scheme. Only
+// priorities out of place are moved, with the priority move endpoint, instead of removing and re-adding them.
+func (r *prioritySchemeResource) orderPriorities(ctx context.Context, id string, want []string) (*prioritySchemeAPIModel, *models.ResponseScheme, error) {
+	current, rs, err := r.getScheme(ctx, id)
+	if err != nil || slices.Equal(current.PriorityIDs, want) {
+		return current, rs, err
+	}
+	for _, m := range planPriorityMoves(current.PriorityIDs, want) {
+		if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/priority/move", m, nil); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getScheme(ctx, id)
+}
+
+// scopeProjects is the post hook for every operation: it drops the projects from the result when project_ids
+// is not managed, so projects assigned outside Terraform do not show up as drift.
+func (r *prioritySchemeResource) scopeProjects(_ context.Context, api *prioritySchemeAPIModel, st *prioritySchemeResourceModel) (*prioritySchemeAPIModel, *models.ResponseScheme, error) {
+	if st.ProjectIDs.IsNull() {
+		api.ProjectIDs = nil
+	}
+	return api, &models.ResponseScheme{Code: http.StatusOK}, nil
+}
+
+// idChanges builds the add/remove object the priority scheme update endpoint takes for priorities and projects.
+func idChanges(added, removed []string) map[string]any {
+	changes := map[string]any{}
+	if len(added) > 0 {
+		changes["add"] = map[string]any{"ids": idNumbers(added)}
+	}
+	if len(removed) > 0 {
+		changes["remove"] = map[string]any{"ids": idNumbers(removed)}
+	}
+	return changes
+}
+
+// prioritySchemePageQuery encodes the startAt/maxResults query of a priority scheme page.
+func prioritySchemePageQuery(startAt int) string {
+	return url.Values{
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(prioritySchemePageSize)},
+	}.Encode()
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *prioritySchemeResource) hooks() CRUDHooks[prioritySchemeResourceModel, *prioritySchemePayload, *prioritySchemeAPIModel] {
+	return CRUDHooks[prioritySchemeResourceModel, *prioritySchemePayload, *prioritySchemeAPIModel]{
+		BuildPayload: func(ctx context.Context, st *prioritySchemeResourceModel) (*prioritySchemePayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var priorityIDs []string
+			diags.Append(st.PriorityIDs.ElementsAs(ctx, &priorityIDs, false)...)
+			return &prioritySchemePayload{
+				Name:              st.Name.ValueString(),
+				Description:       st.Description.ValueString(),
+				PriorityIDs:       priorityIDs,
+				DefaultPriorityID: st.DefaultPriorityID.ValueString(),
+				ProjectIDs:        setStrings(ctx, st.ProjectIDs, &diags),
+			}, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *prioritySchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapPrioritySchemeToModel,
+		PostCreate:              r.scopeProjects,
+		PostRead:                r.scopeProjects,
+		PostUpdate:              r.scopeProjects,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *prioritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *prioritySchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *prioritySchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *prioritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *prioritySchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *prioritySchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *prioritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *prioritySchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *prioritySchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *prioritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *prioritySchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *prioritySchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *prioritySchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
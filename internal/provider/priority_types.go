@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"slices"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hexColorRegex matches the #RGB and #RRGGBB colours Jira accepts for priorities.
+var hexColorRegex = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// priorityResourceModel models the Terraform schema/state for jira_priority. It is also the element type of the
+// priorities list of the jira_priorities data source.
+type priorityResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	StatusColor types.String `tfsdk:"status_color"`
+	IconURL     types.String `tfsdk:"icon_url"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+func (m *priorityResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"name":         types.StringType,
+		"description":  types.StringType,
+		"status_color": types.StringType,
+		"icon_url":     types.StringType,
+		"is_default":   types.BoolType,
+	}
+}
+
+// priorityPayload carries the planned priority for create/update. go-atlassian only reads priorities, so the
+// provider defines its own request and response types.
+type priorityPayload struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	StatusColor string `json:"statusColor"`
+	IconURL     string `json:"iconUrl,omitempty"`
+	IsDefault   bool   `json:"-"`
+}
+
+// priorityAPIModel is the priority as returned by the priority search endpoint, which also reports the default.
+type priorityAPIModel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	StatusColor string `json:"statusColor"`
+	IconURL     string `json:"iconUrl"`
+	IsDefault   bool   `json:"isDefault"`
+}
+
+// priorityPage is a page of priorities as returned by Jira, in priority order.
+type priorityPage struct {
+	IsLast bool                `json:"isLast"`
+	Values []*priorityAPIModel `json:"values"`
+}
+
+// mapPriorityToModel centralizes mapping for the priority resource and matches CRUDHooks MapToState signature.
+func mapPriorityToModel(_ context.Context, api *priorityAPIModel, st *priorityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no priority payload to map into state.")
+		return diags
+	}
+	*st = priorityResourceModel{
+		ID:          types.StringValue(api.ID),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+		StatusColor: types.StringValue(api.StatusColor),
+		IconURL:     stringOrNull(api.IconURL),
+		IsDefault:   types.BoolValue(api.IsDefault),
+	}
+	return diags
+}
+
+// prioritySchemeResourceModel models the Terraform schema/state for jira_priority_scheme.
+type prioritySchemeResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	PriorityIDs       types.List   `tfsdk:"priority_ids"`
+	DefaultPriorityID types.String `tfsdk:"default_priority_id"`
+	ProjectIDs        types.Set    `tfsdk:"project_ids"`
+}
+
+func (m *prioritySchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                  types.StringType,
+		"name":                types.StringType,
+		"description":         types.StringType,
+		"priority_ids":        types.ListType{ElemType: types.StringType},
+		"default_priority_id": types.StringType,
+		"project_ids":         types.SetType{ElemType: types.StringType},
+	}
+}
+
+// prioritySchemePayload carries the planned priority scheme for create/update.
+type prioritySchemePayload struct {
+	Name              string
+	Description       string
+	PriorityIDs       []string
+	DefaultPriorityID string
+	ProjectIDs        []string
+}
+
+// prioritySchemeAPIModel is the priority scheme as returned by Jira, together with its priorities in order and
+// its projects.
+type prioritySchemeAPIModel struct {
+	ID                json.Number `json:"id"`
+	Name              string      `json:"name"`
+	Description       string      `json:"description"`
+	DefaultPriorityID json.Number `json:"defaultPriorityId"`
+	PriorityIDs       []string    `json:"-"`
+	ProjectIDs        []string    `json:"-"`
+}
+
+// prioritySchemePage is a page of priority schemes as returned by Jira.
+type prioritySchemePage struct {
+	IsLast bool                      `json:"isLast"`
+	Values []*prioritySchemeAPIModel `json:"values"`
+}
+
+// prioritySchemeProjectPage is a page of the projects of a priority scheme as returned by Jira.
+type prioritySchemeProjectPage struct {
+	IsLast bool `json:"isLast"`
+	Values []*struct {
+		ID string `json:"id"`
+	} `json:"values"`
+}
+
+// prioritySchemeTaskResponse is the response of the priority scheme create and update endpoints. Jira migrates
+// the issues of added or removed projects asynchronously and returns the task doing it.
+type prioritySchemeTaskResponse struct {
+	ID   json.Number        `json:"id"`
+	Task *models.TaskScheme `json:"task"`
+}
+
+// mapPrioritySchemeToModel centralizes mapping for the priority scheme resource and matches CRUDHooks MapToState signature.
+func mapPrioritySchemeToModel(ctx context.Context, api *prioritySchemeAPIModel, st *prioritySchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no priority scheme payload to map into state.")
+		return diags
+	}
+	priorityIDs, d := types.ListValueFrom(ctx, types.StringType, api.PriorityIDs)
+	diags.Append(d...)
+	projectIDs, d := stringSetOrNull(ctx, api.ProjectIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = prioritySchemeResourceModel{
+		ID:                types.StringValue(api.ID.String()),
+		Name:              types.StringValue(api.Name),
+		Description:       stringOrNull(api.Description),
+		PriorityIDs:       priorityIDs,
+		DefaultPriorityID: types.StringValue(api.DefaultPriorityID.String()),
+		ProjectIDs:        projectIDs,
+	}
+	return diags
+}
+
+// idNumbers converts numeric string IDs into JSON numbers for endpoints that take IDs as integers.
+func idNumbers(ids []string) []json.Number {
+	out := make([]json.Number, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, json.Number(id))
+	}
+	return out
+}
+
+// priorityMove is a request to the priority move endpoint: the priorities in IDs are placed, in order, right
+// after the priority After, or at Position when After is empty.
+type priorityMove struct {
+	IDs      []string `json:"ids"`
+	After    string   `json:"after,omitempty"`
+	Position string   `json:"position,omitempty"`
+}
+
+// planPriorityMoves returns the moves that put the priorities of have into the order of want. The longest run of
+// priorities already in the right relative order stays in place and only the others are moved, so reordering a
+// scheme disturbs as few priorities as possible. Priorities missing from either list are ignored.
+func planPriorityMoves(have, want []string) []priorityMove {
+	// Positions in have of the wanted priorities, in wanted order; -1 marks priorities missing from have.
+	pos := make([]int, len(want))
+	for i, id := range want {
+		pos[i] = slices.Index(have, id)
+	}
+
+	// Longest increasing subsequence of pos: these priorities keep their place.
+	length := make([]int, len(want))
+	prev := make([]int, len(want))
+	best := -1
+	for i := range want {
+		prev[i] = -1
+		if pos[i] < 0 {
+			continue
+		}
+		length[i] = 1
+		for j := 0; j < i; j++ {
+			if pos[j] >= 0 && pos[j] < pos[i] && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+		if best < 0 || length[i] > length[best] {
+			best = i
+		}
+	}
+	keep := make([]bool, len(want))
+	for i := best; i >= 0; i = prev[i] {
+		keep[i] = true
+	}
+
+	// Each priority out of place is moved right after its wanted predecessor; runs of them move together.
+	var moves []priorityMove
+	after := ""
+	for i, id := range want {
+		if pos[i] < 0 {
+			continue
+		}
+		if !keep[i] {
+			if n := len(moves); n > 0 && after != "" && moves[n-1].IDs[len(moves[n-1].IDs)-1] == after {
+				moves[n-1].IDs = append(moves[n-1].IDs, id)
+			} else if after == "" {
+				moves = append(moves, priorityMove{IDs: []string{id}, Position: "First"})
+			} else {
+				moves = append(moves, priorityMove{IDs: []string{id}, After: after})
+			}
+		}
+		after = id
+	}
+	return moves
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*projectComponentResource)(nil)
+var _ resource.ResourceWithConfigure = (*projectComponentResource)(nil)
+var _ resource.ResourceWithImportState = (*projectComponentResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*projectComponentResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*projectComponentResource)(nil)
+
+// NewProjectComponentResource returns the Terraform resource implementation for jira_project_component.
+func NewProjectComponentResource() resource.Resource { return &projectComponentResource{} }
+
+type projectComponentResource struct {
+	ServiceClient
+	componentService jira.ProjectComponentConnector
+	crudRunner       CRUDRunner[projectComponentResourceModel, *projectComponentPayload, *models.ComponentScheme]
+}
+
+func (r *projectComponentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_component"
+}
+
+func (r *projectComponentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.componentService = provider.client.Project.Component
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *projectComponentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a component of a Jira project, used to group the project's work items.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the component. Automatically generated by Jira when the component is created. Import the component with an ID of the form `PROJECTKEY/id`, for example `PROJ/10000`.",
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Key of the project the component belongs to. Changing this forces a new component.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the component. Must be unique within the project.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the component.",
+			},
+			"lead_account_id": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Account ID of the component lead. When the provider can reach Jira during planning, the account " +
+					"is checked to exist and be active.",
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"assignee_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(componentAssigneeProjectDefault),
+				MarkdownDescription: "Who work items with this component are assigned to by default: `PROJECT_DEFAULT`, `COMPONENT_LEAD` " +
+					"(requires `lead_account_id`), `PROJECT_LEAD` or `UNASSIGNED`. Defaults to `PROJECT_DEFAULT`.",
+				Validators: []validator.String{stringvalidator.OneOf(
+					componentAssigneeProjectDefault,
+					componentAssigneeComponentLead,
+					componentAssigneeProjectLead,
+					componentAssigneeUnassigned,
+				)},
+			},
+		},
+	}
+}
+
+func (r *projectComponentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data projectComponentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AssigneeType.ValueString() == componentAssigneeComponentLead && data.LeadAccountID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lead_account_id"),
+			"Missing component lead",
+			"'lead_account_id' must be set when 'assignee_type' is COMPONENT_LEAD.",
+		)
+	}
+}
+
+// ModifyPlan checks a new or changed component lead against Jira, so a mistyped account ID fails at plan time.
+func (r *projectComponentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	var plan projectComponentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.LeadAccountID.IsNull() || plan.LeadAccountID.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state projectComponentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.LeadAccountID.Equal(plan.LeadAccountID) {
+			return
+		}
+	}
+	checkAccountAtPlan(ctx, r.client, path.Root("lead_account_id"), plan.LeadAccountID.ValueString(), &resp.Diagnostics)
+}
+
+// Wrapper functions to adapt the component endpoints. Writes are sent directly so that a cleared description or lead
+// reaches Jira (see projectComponentPayload).
+func (r *projectComponentResource) createComponent(ctx context.Context, p *projectComponentPayload) (*models.ComponentScheme, *models.ResponseScheme, error) {
+	var created models.ComponentScheme
+	rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/component", p, &created)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &created, rs, nil
+}
+
+// getComponent reads the component addressed by a "project_key/component_id" key. A component of another project is
+// reported as not found.
+func (r *projectComponentResource) getComponent(ctx context.Context, key string) (*models.ComponentScheme, *models.ResponseScheme, error) {
+	projectKey, id, err := parseProjectComponentKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	component, rs, err := r.componentService.Get(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	if !strings.EqualFold(component.Project, projectKey) {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("component %s not found in project %s", id, projectKey)
+	}
+	return component, rs, nil
+}
+
+func (r *projectComponentResource) updateComponent(ctx context.Context, key string, p *projectComponentPayload) (*models.ComponentScheme, *models.ResponseScheme, error) {
+	_, id, err := parseProjectComponentKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The project cannot change; it forces a new component.
+	body := *p
+	body.Project = ""
+	var updated models.ComponentScheme
+	rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/component/%s", id), &body, &updated)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &updated, rs, nil
+}
+
+func (r *projectComponentResource) deleteComponent(ctx context.Context, key string) (*models.ResponseScheme, error) {
+	_, id, err := parseProjectComponentKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return r.componentService.Delete(ctx, id)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *projectComponentResource) hooks() CRUDHooks[projectComponentResourceModel, *projectComponentPayload, *models.ComponentScheme] {
+	return CRUDHooks[projectComponentResourceModel, *projectComponentPayload, *models.ComponentScheme]{
+		BuildPayload: func(_ context.Context, st *projectComponentResourceModel) (*projectComponentPayload, diag.Diagnostics) {
+			return &projectComponentPayload{
+				Name:          st.Name.ValueString(),
+				Description:   st.Description.ValueString(),
+				Project:       st.ProjectKey.ValueString(),
+				AssigneeType:  st.AssigneeType.ValueString(),
+				LeadAccountID: st.LeadAccountID.ValueString(),
+			}, nil
+		},
+		APICreate:               r.createComponent,
+		APIRead:                 r.getComponent,
+		APIUpdate:               r.updateComponent,
+		APIDelete:               r.deleteComponent,
+		ExtractID:               func(st *projectComponentResourceModel) string { return st.projectComponentKey() },
+		MapToState:              mapProjectComponentToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *projectComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *projectComponentResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectComponentResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *projectComponentResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectComponentResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *projectComponentResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectComponentResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *projectComponentResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectComponentResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	if _, _, err := parseProjectComponentKey(request.ID); err != nil {
+		response.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected an import ID of the form project_key/component_id, for example PROJ/10000: %s", err))
+		return
+	}
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *projectComponentResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectComponentResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_project_component.test"
+	key := randomProjectKey(6)
+	name := strings.ReplaceAll(acctest.RandomWithPrefix(accPrefixComponent), "_", "-")
+	leadAccountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetProjectComponentCfg(t, testhelpers.ProjectComponentTmplCfg{
+					ProjectKey:    key,
+					Name:          name,
+					LeadAccountID: leadAccountID,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("project_key"), knownvalue.StringExact(key)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lead_account_id"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("assignee_type"), knownvalue.StringExact(componentAssigneeProjectDefault)),
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config: testhelpers.GetProjectComponentCfg(t, testhelpers.ProjectComponentTmplCfg{
+					ProjectKey:    key,
+					Name:          name,
+					Description:   "Updated component description",
+					LeadAccountID: leadAccountID,
+					ComponentLead: true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Updated component description")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lead_account_id"), knownvalue.StringExact(leadAccountID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("assignee_type"), knownvalue.StringExact(componentAssigneeComponentLead)),
+				},
+			},
+			{
+				// Removing the description and lead clears them in Jira.
+				Config: testhelpers.GetProjectComponentCfg(t, testhelpers.ProjectComponentTmplCfg{
+					ProjectKey:    key,
+					Name:          name,
+					LeadAccountID: leadAccountID,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lead_account_id"), knownvalue.Null()),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource %s not found in state", resourceName)
+					}
+					return rs.Primary.Attributes["project_key"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+			{
+				ImportState:   true,
+				ResourceName:  resourceName,
+				ImportStateId: "not-a-component-id",
+				ExpectError:   regexp.MustCompile(`Invalid import ID`),
+			},
+		},
+	})
+}
+
+func TestAccProjectComponentResource_unknownLead(t *testing.T) {
+	t.Parallel()
+
+	// A lead that does not exist fails at plan time, before the project is created.
+	key := randomProjectKey(6)
+	cfg := fmt.Sprintf(`
+resource "jira_project_component" "test" {
+  project_key     = %q
+  name            = "tf-acc-component-unknown-lead"
+  lead_account_id = "000000:00000000-0000-0000-0000-000000000000"
+}
+`, key)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Unknown account`),
+			},
+		},
+	})
+}
+
+func TestMapProjectComponentToModelKeepsProjectKeyCase(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	api := &models.ComponentScheme{ID: "10000", Name: "Backend", Project: "PROJ", AssigneeType: "PROJECT_DEFAULT"}
+	st := projectComponentResourceModel{ProjectKey: types.StringValue("proj")}
+	if diags := mapProjectComponentToModel(ctx, api, &st); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := st.ProjectKey.ValueString(); got != "proj" {
+		t.Fatalf("expected the configured project key to be kept, got %q", got)
+	}
+
+	st = projectComponentResourceModel{ProjectKey: types.StringValue("OTHER")}
+	if diags := mapProjectComponentToModel(ctx, api, &st); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := st.ProjectKey.ValueString(); got != "PROJ" {
+		t.Fatalf("expected Jira's project key on mismatch, got %q", got)
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default assignee types of a project component.
+const (
+	componentAssigneeProjectDefault = "PROJECT_DEFAULT"
+	componentAssigneeComponentLead  = "COMPONENT_LEAD"
+	componentAssigneeProjectLead    = "PROJECT_LEAD"
+	componentAssigneeUnassigned     = "UNASSIGNED"
+)
+
+// projectComponentResourceModel models the Terraform schema/state for jira_project_component.
+type projectComponentResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	LeadAccountID types.String `tfsdk:"lead_account_id"`
+	AssigneeType  types.String `tfsdk:"assignee_type"`
+}
+
+// projectComponentKey returns the "project_key/component_id" key the component is addressed by; it is also the
+// import ID.
+func (m *projectComponentResourceModel) projectComponentKey() string {
+	return m.ProjectKey.ValueString() + "/" + m.ID.ValueString()
+}
+
+// parseProjectComponentKey splits a "project_key/component_id" key into the project key and the numeric component ID.
+func parseProjectComponentKey(key string) (projectKey, componentID string, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("invalid project component id %q: expected format project_key/component_id", key)
+	}
+	if !numericIDRegex.MatchString(parts[1]) {
+		return "", "", fmt.Errorf("invalid project component id %q: component ID must be numeric", parts[1])
+	}
+	return parts[0], parts[1], nil
+}
+
+// projectComponentPayload carries the planned component for create/update. go-atlassian omits empty properties,
+// while Jira only clears a description or lead that is sent as an empty string, so the provider defines its own.
+type projectComponentPayload struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Project       string `json:"project,omitempty"`
+	AssigneeType  string `json:"assigneeType"`
+	LeadAccountID string `json:"leadAccountId"`
+}
+
+// mapProjectComponentToModel centralizes mapping for the component resource and matches CRUDHooks MapToState signature.
+func mapProjectComponentToModel(_ context.Context, api *models.ComponentScheme, st *projectComponentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no component payload to map into state.")
+		return diags
+	}
+	lead := types.StringNull()
+	if api.Lead != nil && api.Lead.AccountID != "" {
+		lead = types.StringValue(api.Lead.AccountID)
+	}
+	// Project keys match case-insensitively; keeping the key from state avoids a diff that would replace the component.
+	projectKey := types.StringValue(api.Project)
+	if strings.EqualFold(st.ProjectKey.ValueString(), api.Project) {
+		projectKey = st.ProjectKey
+	}
+	*st = projectComponentResourceModel{
+		ID:            types.StringValue(api.ID),
+		ProjectKey:    projectKey,
+		Name:          types.StringValue(api.Name),
+		Description:   stringOrNull(api.Description),
+		LeadAccountID: lead,
+		AssigneeType:  types.StringValue(api.AssigneeType),
+	}
+	return diags
+}
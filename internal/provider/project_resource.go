@@ -5,17 +5,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = (*projectResource)(nil)
@@ -27,7 +30,7 @@ func NewProjectResource() resource.Resource { return &projectResource{} }
 
 type projectResource struct {
 	ServiceClient
-	crudRunner CRUDRunner[projectResourceModel, *models.ProjectPayloadScheme, *models.ProjectScheme]
+	crudRunner CRUDRunner[projectWithSchemesResourceModel, *models.ProjectPayloadScheme, *projectAPIModel]
 }
 
 func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -98,12 +101,45 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 				MarkdownDescription: "Project category ID.",
 			},
+			"workflow_scheme_id": projectSchemeIDAttribute(
+				"ID of the workflow scheme associated with the project. Null while the project uses the Default Workflow Scheme.",
+			),
+			"issue_type_scheme_id": projectSchemeIDAttribute(
+				"ID of the issue type (work type) scheme associated with the project.",
+			),
+			"issue_type_screen_scheme_id": projectSchemeIDAttribute(
+				"ID of the issue type screen scheme associated with the project.",
+			),
+			"field_configuration_scheme_id": projectSchemeIDAttribute(
+				"ID of the field configuration scheme associated with the project. Null while the project uses the default field configuration scheme.",
+			),
+			"permission_scheme_id": projectSchemeIDAttribute(
+				"ID of the permission scheme associated with the project.",
+			),
+			"notification_scheme_id": projectSchemeIDAttribute(
+				"ID of the notification scheme associated with the project.",
+			),
+			"issue_security_scheme_id": projectSchemeIDAttribute(
+				"ID of the issue security scheme associated with the project. Null when the project has none.",
+			),
 		},
 	}
 }
 
+// projectSchemeIDAttribute builds the shared schema for the scheme association attributes. An omitted attribute is
+// neither looked up nor reassigned and stays null; an imported project starts with every association looked up.
+func projectSchemeIDAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric scheme ID")},
+		MarkdownDescription: description,
+	}
+}
+
 // Wrapper functions to adapt go-atlassian signatures for this resource.
-func (r *projectResource) createProject(ctx context.Context, p *models.ProjectPayloadScheme) (*models.ProjectScheme, *models.ResponseScheme, error) {
+func (r *projectResource) createProject(ctx context.Context, p *models.ProjectPayloadScheme) (*projectAPIModel, *models.ResponseScheme, error) {
 	created, rs, err := r.client.Project.Create(ctx, p)
 	if err != nil || created == nil {
 		return nil, rs, err
@@ -114,15 +150,156 @@ func (r *projectResource) createProject(ctx context.Context, p *models.ProjectPa
 	} else if p != nil && p.Key != "" {
 		id = p.Key
 	}
-	proj, rs2, err2 := r.client.Project.Get(ctx, id, nil)
-	if err2 != nil {
-		return nil, rs2, err2
+	return r.getProject(ctx, id)
+}
+
+// getProject reads the project itself; its scheme associations are looked up by readProjectSchemes.
+func (r *projectResource) getProject(ctx context.Context, id string) (*projectAPIModel, *models.ResponseScheme, error) {
+	proj, rs, err := r.client.Project.Get(ctx, id, nil)
+	if err != nil || proj == nil {
+		return nil, rs, err
+	}
+	return &projectAPIModel{ProjectScheme: proj}, rs, nil
+}
+
+// readProjectSchemes is the post-read hook that looks up the scheme associations tracked by st. On import st is
+// empty, so every association is looked up.
+func (r *projectResource) readProjectSchemes(ctx context.Context, api *projectAPIModel, st *projectWithSchemesResourceModel) (*projectAPIModel, *models.ResponseScheme, error) {
+	tracked := &st.projectSchemeAssociationsModel
+	if st.ID.IsNull() {
+		tracked = nil
+	}
+	ids, rs, err := r.getProjectSchemeIDs(ctx, api.ID, tracked)
+	if err != nil {
+		return nil, rs, err
+	}
+	api.SchemeIDs = ids
+	return api, rs, nil
+}
+
+// reconcileProjectSchemes is the post-create and post-update hook that assigns every configured scheme whose ID
+// differs from the current association.
+func (r *projectResource) reconcileProjectSchemes(ctx context.Context, api *projectAPIModel, st *projectWithSchemesResourceModel) (*projectAPIModel, *models.ResponseScheme, error) {
+	planned := st.projectSchemeAssociationsModel
+	current, rs, err := r.getProjectSchemeIDs(ctx, api.ID, &planned)
+	if err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.assignProjectSchemes(ctx, api.ID, planned, &current); err != nil {
+		return nil, rs, err
+	}
+	api.SchemeIDs = current
+	return api, rs, nil
+}
+
+// schemeTracked reports whether a scheme association attribute has a value in state or config. Only tracked
+// associations are looked up, which spares a request per association the configuration does not manage.
+func schemeTracked(v types.String) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+// getProjectSchemeIDs looks up the schemes associated with the project through the per-scheme association endpoints.
+// When tracked is non-nil, only the associations it tracks are looked up and the others are left empty.
+func (r *projectResource) getProjectSchemeIDs(ctx context.Context, projectID string, tracked *projectSchemeAssociationsModel) (projectSchemeIDs, *models.ResponseScheme, error) {
+	var ids projectSchemeIDs
+	pid, err := strconv.Atoi(projectID)
+	if err != nil {
+		return ids, nil, fmt.Errorf("invalid project id %q: %w", projectID, err)
+	}
+	all := tracked == nil
+
+	if all || schemeTracked(tracked.WorkflowSchemeID) {
+		workflowSchemes, rs, err := r.client.Workflow.Scheme.Associations(ctx, []int{pid})
+		if err != nil {
+			return ids, rs, err
+		}
+		for _, v := range workflowSchemes.Values {
+			// The Default Workflow Scheme is returned without an ID.
+			if v.WorkflowScheme != nil && v.WorkflowScheme.ID != 0 {
+				ids.Workflow = strconv.Itoa(v.WorkflowScheme.ID)
+			}
+		}
+	}
+
+	if all || schemeTracked(tracked.IssueTypeSchemeID) {
+		typeSchemes, rs, err := r.client.Issue.Type.Scheme.Projects(ctx, []int{pid}, 0, 50)
+		if err != nil {
+			return ids, rs, err
+		}
+		for _, v := range typeSchemes.Values {
+			if v.IssueTypeScheme != nil {
+				ids.IssueType = v.IssueTypeScheme.ID
+			}
+		}
+	}
+
+	if all || schemeTracked(tracked.IssueTypeScreenSchemeID) {
+		screenSchemes, rs, err := r.client.Issue.Type.ScreenScheme.Projects(ctx, []int{pid}, 0, 50)
+		if err != nil {
+			return ids, rs, err
+		}
+		for _, v := range screenSchemes.Values {
+			if v.IssueTypeScreenScheme != nil {
+				ids.IssueTypeScreen = v.IssueTypeScreenScheme.ID
+			}
+		}
+	}
+
+	if all || schemeTracked(tracked.FieldConfigurationSchemeID) {
+		fieldConfigSchemes, rs, err := r.client.Issue.Field.Configuration.Scheme.Project(ctx, []int{pid}, 0, 50)
+		if err != nil {
+			return ids, rs, err
+		}
+		for _, v := range fieldConfigSchemes.Values {
+			if v.FieldConfigurationScheme != nil {
+				ids.FieldConfiguration = v.FieldConfigurationScheme.ID
+			}
+		}
+	}
+
+	if all || schemeTracked(tracked.PermissionSchemeID) {
+		permissionScheme, rs, err := r.client.Project.Permission.Get(ctx, projectID, nil)
+		if err != nil {
+			return ids, rs, err
+		}
+		// The Default Permission Scheme commonly has ID 0, so a returned scheme is always mapped.
+		if permissionScheme != nil {
+			ids.Permission = strconv.Itoa(permissionScheme.ID)
+		}
+	}
+
+	if all || schemeTracked(tracked.NotificationSchemeID) {
+		notificationScheme, rs, err := r.client.Project.NotificationScheme(ctx, projectID, nil)
+		if err != nil {
+			return ids, rs, err
+		}
+		if notificationScheme != nil && notificationScheme.ID != 0 {
+			ids.Notification = strconv.Itoa(notificationScheme.ID)
+		}
+	}
+
+	if all || schemeTracked(tracked.IssueSecuritySchemeID) {
+		issueSecurity, rs, err := r.getProjectIssueSecuritySchemeID(ctx, projectID)
+		if err != nil {
+			return ids, rs, err
+		}
+		ids.IssueSecurity = issueSecurity
 	}
-	return proj, rs2, nil
+	return ids, &models.ResponseScheme{Code: http.StatusOK}, nil
 }
 
-func (r *projectResource) getProject(ctx context.Context, id string) (*models.ProjectScheme, *models.ResponseScheme, error) {
-	return r.client.Project.Get(ctx, id, nil)
+// getProjectIssueSecuritySchemeID returns the project's issue security scheme ID, or "" when it has none.
+// go-atlassian has no wrapper for this endpoint; Jira answers 404 for projects without an issue security scheme.
+func (r *projectResource) getProjectIssueSecuritySchemeID(ctx context.Context, projectID string) (string, *models.ResponseScheme, error) {
+	var scheme models.SecurityScheme
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/project/%s/issuesecuritylevelscheme", projectID), nil, &scheme)
+	if err != nil {
+		if HTTPStatusFromScheme(rs) == http.StatusNotFound {
+			return "", rs, nil
+		}
+		return "", rs, err
+	}
+	return scheme.ID, rs, nil
 }
 
 func (r *projectResource) deleteProject(ctx context.Context, id string) (*models.ResponseScheme, error) {
@@ -130,9 +307,9 @@ func (r *projectResource) deleteProject(ctx context.Context, id string) (*models
 }
 
 // hooks returns the CRUD hooks for the generic runner.
-func (r *projectResource) hooks() CRUDHooks[projectResourceModel, *models.ProjectPayloadScheme, *models.ProjectScheme] {
-	return CRUDHooks[projectResourceModel, *models.ProjectPayloadScheme, *models.ProjectScheme]{
-		BuildPayload: func(ctx context.Context, st *projectResourceModel) (*models.ProjectPayloadScheme, diag.Diagnostics) {
+func (r *projectResource) hooks() CRUDHooks[projectWithSchemesResourceModel, *models.ProjectPayloadScheme, *projectAPIModel] {
+	return CRUDHooks[projectWithSchemesResourceModel, *models.ProjectPayloadScheme, *projectAPIModel]{
+		BuildPayload: func(ctx context.Context, st *projectWithSchemesResourceModel) (*models.ProjectPayloadScheme, diag.Diagnostics) {
 			var diags diag.Diagnostics
 			p := &models.ProjectPayloadScheme{
 				Key:            st.Key.ValueString(),
@@ -145,14 +322,41 @@ func (r *projectResource) hooks() CRUDHooks[projectResourceModel, *models.Projec
 			if !st.CategoryID.IsNull() && !st.CategoryID.IsUnknown() {
 				p.CategoryID = int(st.CategoryID.ValueInt64())
 			}
+			schemeIDs := []struct {
+				attr  string
+				value types.String
+				dst   *int
+			}{
+				{"workflow_scheme_id", st.WorkflowSchemeID, &p.WorkflowScheme},
+				{"issue_type_scheme_id", st.IssueTypeSchemeID, &p.IssueTypeScheme},
+				{"issue_type_screen_scheme_id", st.IssueTypeScreenSchemeID, &p.IssueTypeScreenScheme},
+				{"field_configuration_scheme_id", st.FieldConfigurationSchemeID, &p.FieldConfigurationScheme},
+				{"permission_scheme_id", st.PermissionSchemeID, &p.PermissionScheme},
+				{"notification_scheme_id", st.NotificationSchemeID, &p.NotificationScheme},
+				{"issue_security_scheme_id", st.IssueSecuritySchemeID, &p.IssueSecurityScheme},
+			}
+			for _, s := range schemeIDs {
+				if s.value.IsNull() || s.value.IsUnknown() {
+					continue
+				}
+				n, err := strconv.Atoi(s.value.ValueString())
+				if err != nil {
+					diags.AddAttributeError(path.Root(s.attr), "Invalid scheme ID", fmt.Sprintf("%q is not a numeric scheme ID.", s.value.ValueString()))
+					continue
+				}
+				*s.dst = n
+			}
 			return p, diags
 		},
 		APICreate:               r.createProject, // already does Create→Get
 		APIRead:                 r.getProject,
 		APIUpdate:               r.updateProject, // already does Update→Get
 		APIDelete:               r.deleteProject,
-		ExtractID:               func(st *projectResourceModel) string { return st.ID.ValueString() },
-		MapToState:              mapProjectSchemeToModel,
+		ExtractID:               func(st *projectWithSchemesResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapProjectAPIModelToModel,
+		PostCreate:              r.reconcileProjectSchemes,
+		PostRead:                r.readProjectSchemes,
+		PostUpdate:              r.reconcileProjectSchemes,
 		TreatDelete404AsSuccess: true,
 	}
 }
@@ -163,10 +367,10 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags := r.crudRunner.DoCreate(
 		ctx,
-		func(ctx context.Context, dst *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, dst *projectWithSchemesResourceModel) diag.Diagnostics {
 			return req.Plan.Get(ctx, dst)
 		},
-		func(ctx context.Context, src *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, src *projectWithSchemesResourceModel) diag.Diagnostics {
 			return resp.State.Set(ctx, src)
 		},
 		ensureWith(&resp.Diagnostics),
@@ -180,12 +384,12 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	diags := r.crudRunner.DoRead(
 		ctx,
-		func(ctx context.Context, dst *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, dst *projectWithSchemesResourceModel) diag.Diagnostics {
 			var d diag.Diagnostics
 			d.Append(req.State.Get(ctx, dst)...)
 			return d
 		},
-		func(ctx context.Context, src *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, src *projectWithSchemesResourceModel) diag.Diagnostics {
 			return resp.State.Set(ctx, src)
 		},
 		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
@@ -195,9 +399,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(diags...)
 }
 
-// updateProject converts a ProjectPayloadScheme into a ProjectUpdateScheme,
-// performs the update, and then reads back the full project for state mapping.
-func (r *projectResource) updateProject(ctx context.Context, id string, p *models.ProjectPayloadScheme) (*models.ProjectScheme, *models.ResponseScheme, error) {
+// updateProject converts a ProjectPayloadScheme into a ProjectUpdateScheme, performs the update, and then reads
+// back the project for state mapping. Scheme associations are reconciled by the post-update hook.
+func (r *projectResource) updateProject(ctx context.Context, id string, p *models.ProjectPayloadScheme) (*projectAPIModel, *models.ResponseScheme, error) {
 	u := &models.ProjectUpdateScheme{
 		Name:          p.Name,
 		Description:   p.Description,
@@ -212,12 +416,83 @@ func (r *projectResource) updateProject(ctx context.Context, id string, p *model
 	if err != nil {
 		return nil, rs, err
 	}
+	return r.getProject(ctx, id)
+}
 
-	proj, rs2, err2 := r.client.Project.Get(ctx, id, nil)
-	if err2 != nil {
-		return nil, rs2, err2
+// assignProjectSchemes calls the association endpoint of every configured scheme whose planned ID differs from the
+// current one, and records each assigned ID in current.
+func (r *projectResource) assignProjectSchemes(ctx context.Context, projectID string, planned projectSchemeAssociationsModel, current *projectSchemeIDs) (*models.ResponseScheme, error) {
+	if schemeIDChanged(planned.WorkflowSchemeID, current.Workflow) {
+		if rs, err := r.client.Workflow.Scheme.Assign(ctx, planned.WorkflowSchemeID.ValueString(), projectID); err != nil {
+			return rs, err
+		}
+		current.Workflow = planned.WorkflowSchemeID.ValueString()
+	}
+	if schemeIDChanged(planned.IssueTypeSchemeID, current.IssueType) {
+		if rs, err := r.client.Issue.Type.Scheme.Assign(ctx, planned.IssueTypeSchemeID.ValueString(), projectID); err != nil {
+			return rs, err
+		}
+		current.IssueType = planned.IssueTypeSchemeID.ValueString()
 	}
-	return proj, rs2, nil
+	if schemeIDChanged(planned.IssueTypeScreenSchemeID, current.IssueTypeScreen) {
+		if rs, err := r.client.Issue.Type.ScreenScheme.Assign(ctx, planned.IssueTypeScreenSchemeID.ValueString(), projectID); err != nil {
+			return rs, err
+		}
+		current.IssueTypeScreen = planned.IssueTypeScreenSchemeID.ValueString()
+	}
+	if schemeIDChanged(planned.FieldConfigurationSchemeID, current.FieldConfiguration) {
+		payload := &models.FieldConfigurationSchemeAssignPayload{
+			FieldConfigurationSchemeID: planned.FieldConfigurationSchemeID.ValueString(),
+			ProjectID:                  projectID,
+		}
+		if rs, err := r.client.Issue.Field.Configuration.Scheme.Assign(ctx, payload); err != nil {
+			return rs, err
+		}
+		current.FieldConfiguration = planned.FieldConfigurationSchemeID.ValueString()
+	}
+	if schemeIDChanged(planned.PermissionSchemeID, current.Permission) {
+		id, err := strconv.Atoi(planned.PermissionSchemeID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid permission scheme id %q: %w", planned.PermissionSchemeID.ValueString(), err)
+		}
+		if _, rs, err := r.client.Project.Permission.Assign(ctx, projectID, id); err != nil {
+			return rs, err
+		}
+		current.Permission = planned.PermissionSchemeID.ValueString()
+	}
+	// Notification and issue security schemes have no dedicated association endpoint; they are set on the project
+	// itself. The body is sent as a map because ProjectUpdateScheme omits zero IDs.
+	body := map[string]any{}
+	for _, s := range []struct {
+		key     string
+		planned types.String
+		current *string
+	}{
+		{"notificationScheme", planned.NotificationSchemeID, &current.Notification},
+		{"issueSecurityScheme", planned.IssueSecuritySchemeID, &current.IssueSecurity},
+	} {
+		if !schemeIDChanged(s.planned, *s.current) {
+			continue
+		}
+		id, err := strconv.Atoi(s.planned.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s id %q: %w", s.key, s.planned.ValueString(), err)
+		}
+		body[s.key] = id
+		*s.current = s.planned.ValueString()
+	}
+	if len(body) > 0 {
+		if rs, err := callJira(ctx, r.client, http.MethodPut, "rest/api/3/project/"+projectID, body, nil); err != nil {
+			return rs, err
+		}
+	}
+	return nil, nil
+}
+
+// schemeIDChanged reports whether a configured scheme ID differs from the current association. Null and unknown
+// values are not configured and never trigger an assignment.
+func schemeIDChanged(planned types.String, current string) bool {
+	return schemeTracked(planned) && planned.ValueString() != current
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -226,10 +501,10 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	diags := r.crudRunner.DoUpdate(
 		ctx,
-		func(ctx context.Context, dst *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, dst *projectWithSchemesResourceModel) diag.Diagnostics {
 			return req.Plan.Get(ctx, dst)
 		},
-		func(ctx context.Context, src *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, src *projectWithSchemesResourceModel) diag.Diagnostics {
 			return resp.State.Set(ctx, src)
 		},
 		ensureWith(&resp.Diagnostics),
@@ -243,7 +518,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	diags := r.crudRunner.DoDelete(
 		ctx,
-		func(ctx context.Context, dst *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, dst *projectWithSchemesResourceModel) diag.Diagnostics {
 			var d diag.Diagnostics
 			d.Append(req.State.Get(ctx, dst)...)
 			return d
@@ -260,7 +535,7 @@ func (r *projectResource) ImportState(ctx context.Context, request resource.Impo
 	diags := r.crudRunner.DoImport(
 		ctx,
 		request.ID,
-		func(ctx context.Context, src *projectResourceModel) diag.Diagnostics {
+		func(ctx context.Context, src *projectWithSchemesResourceModel) diag.Diagnostics {
 			return response.State.Set(ctx, src)
 		},
 		ensureWith(&response.Diagnostics),
@@ -4,12 +4,18 @@
 package provider
 
 import (
+	"context"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	jira "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -60,6 +66,9 @@ func TestAccProjectResource_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(rName, tfjsonpath.New("key"), knownvalue.StringExact(key)),
 					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
 					statecheck.ExpectKnownValue(rName, tfjsonpath.New("project_type_key"), knownvalue.StringExact(projectType)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("issue_type_scheme_id"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("permission_scheme_id"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("notification_scheme_id"), knownvalue.Null()),
 				},
 			},
 			{
@@ -76,3 +85,87 @@ func TestAccProjectResource_basic(t *testing.T) {
 		},
 	})
 }
+
+// newProjectSchemesTestServer serves the scheme association endpoints of project 10000 and counts the requests.
+func newProjectSchemesTestServer(t *testing.T, requests *int) *jira.Client {
+	t.Helper()
+	responses := map[string]string{
+		"/rest/api/3/workflowscheme/project":           `{"values":[{"projectIds":["10000"],"workflowScheme":{"id":10100}}]}`,
+		"/rest/api/3/issuetypescheme/project":          `{"values":[{"projectIds":["10000"],"issueTypeScheme":{"id":"10001"}}]}`,
+		"/rest/api/3/issuetypescreenscheme/project":    `{"values":[{"projectIds":["10000"],"issueTypeScreenScheme":{"id":"10002"}}]}`,
+		"/rest/api/3/fieldconfigurationscheme/project": `{"values":[{"projectIds":["10000"],"fieldConfigurationScheme":{"id":"10003"}}]}`,
+		"/rest/api/3/project/10000/permissionscheme":   `{"id":0}`,
+		"/rest/api/3/project/10000/notificationscheme": `{"id":10004}`,
+		// No issue security scheme: Jira answers 404.
+		"/rest/api/3/project/10000/issuesecuritylevelscheme": "",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		body, ok := responses[r.URL.Path]
+		if !ok || body == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	client, err := jira.New(server.Client(), server.URL)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return client
+}
+
+func TestReadProjectSchemesOnImport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests int
+	r := &projectResource{}
+	r.client = newProjectSchemesTestServer(t, &requests)
+
+	// DoImport runs the post-read hook with an empty state.
+	var st projectWithSchemesResourceModel
+	api, _, err := r.readProjectSchemes(ctx, &projectAPIModel{ProjectScheme: &models.ProjectScheme{ID: "10000"}}, &st)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := projectSchemeIDs{
+		Workflow:           "10100",
+		IssueType:          "10001",
+		IssueTypeScreen:    "10002",
+		FieldConfiguration: "10003",
+		Permission:         "0",
+		Notification:       "10004",
+	}
+	if api.SchemeIDs != want {
+		t.Fatalf("expected every association on import, got %+v", api.SchemeIDs)
+	}
+	if requests != 7 {
+		t.Fatalf("expected 7 requests, got %d", requests)
+	}
+}
+
+func TestGetProjectSchemeIDsTracked(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests int
+	r := &projectResource{}
+	r.client = newProjectSchemesTestServer(t, &requests)
+
+	ids, _, err := r.getProjectSchemeIDs(ctx, "10000", &projectSchemeAssociationsModel{})
+	if err != nil || ids != (projectSchemeIDs{}) || requests != 0 {
+		t.Fatalf("expected no lookups without tracked attributes, got %+v after %d requests (err %v)", ids, requests, err)
+	}
+
+	tracked := projectSchemeAssociationsModel{
+		PermissionSchemeID:   types.StringValue("0"),
+		NotificationSchemeID: types.StringUnknown(),
+	}
+	ids, _, err = r.getProjectSchemeIDs(ctx, "10000", &tracked)
+	if err != nil || ids != (projectSchemeIDs{Permission: "0"}) || requests != 1 {
+		t.Fatalf("expected only the permission scheme, got %+v after %d requests (err %v)", ids, requests, err)
+	}
+}
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*projectRoleActorsResource)(nil)
+var _ resource.ResourceWithConfigure = (*projectRoleActorsResource)(nil)
+var _ resource.ResourceWithImportState = (*projectRoleActorsResource)(nil)
+
+// NewProjectRoleActorsResource returns the Terraform resource implementation for jira_project_role_actors.
+func NewProjectRoleActorsResource() resource.Resource { return &projectRoleActorsResource{} }
+
+type projectRoleActorsResource struct {
+	ServiceClient
+	roleService  jira.ProjectRoleConnector
+	actorService jira.ProjectRoleActorConnector
+	crudRunner   CRUDRunner[projectRoleActorsResourceModel, *projectRoleActorsPayload, *projectRoleActorsAPIModel]
+}
+
+func (r *projectRoleActorsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_role_actors"
+}
+
+func (r *projectRoleActorsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.roleService = provider.client.Project.Role
+	r.actorService = provider.client.Project.Role.Actor
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *projectRoleActorsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the users and groups assigned to a project role within one project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The identifier of the resource in the form `project/role_id`.",
+			},
+			"project": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID or key of the project. Changing this forces a new resource.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"role_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the project role (for example `jira_project_role.example.id`). Changing this forces a new resource.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project role ID")},
+			},
+			"mode": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(membershipModeAdditive),
+				Validators: []validator.String{stringvalidator.OneOf(membershipModeAdditive, membershipModeAuthoritative)},
+				MarkdownDescription: "How membership is managed. With `additive` (the default) only the listed users and groups are managed, so members added in Jira are kept and never reported as drift. " +
+					"With `authoritative` the listed users and groups are the only members of the role, so any other member is removed.",
+			},
+			"users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Account IDs of the users assigned to the role.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Names of the groups assigned to the role.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *projectRoleActorsResource) createActors(ctx context.Context, p *projectRoleActorsPayload) (*projectRoleActorsAPIModel, *models.ResponseScheme, error) {
+	return r.updateActors(ctx, fmt.Sprintf("%s/%d", p.Project, p.RoleID), p)
+}
+
+// getActors reads the actors of the role by its "project/role_id" key.
+func (r *projectRoleActorsResource) getActors(ctx context.Context, key string) (*projectRoleActorsAPIModel, *models.ResponseScheme, error) {
+	project, roleID, err := parseProjectRoleActorsKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	role, rs, err := r.roleService.Get(ctx, project, roleID)
+	if err != nil {
+		return nil, rs, err
+	}
+	api := newProjectRoleActorsAPIModel(project, role)
+	api.RoleID = roleID
+	return api, rs, nil
+}
+
+// membership adapts the project role actor endpoints for the shared additive/authoritative reconciliation.
+func (r *projectRoleActorsResource) membership(project string, roleID int) membershipReconciler {
+	return membershipReconciler{
+		list: func(ctx context.Context) (membersByKind, *models.ResponseScheme, error) {
+			current, rs, err := r.getActors(ctx, fmt.Sprintf("%s/%d", project, roleID))
+			if err != nil {
+				return nil, rs, err
+			}
+			return membersByKind{memberKindUsers: current.Users, memberKindGroups: current.Groups}, rs, nil
+		},
+		add: func(ctx context.Context, members membersByKind) (*models.ResponseScheme, error) {
+			_, rs, err := r.actorService.Add(ctx, project, roleID, members[memberKindUsers], members[memberKindGroups])
+			return rs, err
+		},
+		remove: func(ctx context.Context, kind, member string) (*models.ResponseScheme, error) {
+			if kind == memberKindGroups {
+				return r.actorService.Delete(ctx, project, roleID, "", member)
+			}
+			return r.actorService.Delete(ctx, project, roleID, member, "")
+		},
+	}
+}
+
+// updateActors reconciles the role actors with the payload and reads them back.
+func (r *projectRoleActorsResource) updateActors(ctx context.Context, key string, p *projectRoleActorsPayload) (*projectRoleActorsAPIModel, *models.ResponseScheme, error) {
+	planned := membersByKind{memberKindUsers: p.Users, memberKindGroups: p.Groups}
+	if rs, err := r.membership(p.Project, p.RoleID).update(ctx, planned, p.Mode); err != nil {
+		return nil, rs, err
+	}
+	return r.getActors(ctx, key)
+}
+
+// removeManaged removes the users and groups of prior that are not in plan; with a nil plan it removes all of them.
+func (r *projectRoleActorsResource) removeManaged(ctx context.Context, prior, plan *projectRoleActorsResourceModel) (*models.ResponseScheme, error) {
+	_, roleID, err := parseProjectRoleActorsKey(prior.projectRoleActorsKey())
+	if err != nil {
+		return nil, err
+	}
+	priorMembers, err := prior.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var planMembers membersByKind
+	if plan != nil {
+		if planMembers, err = plan.members(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return r.membership(prior.Project.ValueString(), roleID).removeManaged(ctx, priorMembers, planMembers)
+}
+
+// managedActors narrows the actors read from Jira to the users and groups in state when membership is additive.
+func managedActors(ctx context.Context, api *projectRoleActorsAPIModel, st *projectRoleActorsResourceModel) (*projectRoleActorsAPIModel, *models.ResponseScheme, error) {
+	state, err := st.members(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	managed := narrowManaged(st.Mode.ValueString(), membersByKind{memberKindUsers: api.Users, memberKindGroups: api.Groups}, state)
+	api.Users, api.Groups = managed[memberKindUsers], managed[memberKindGroups]
+	return api, &models.ResponseScheme{Code: http.StatusOK}, nil
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *projectRoleActorsResource) hooks() CRUDHooks[projectRoleActorsResourceModel, *projectRoleActorsPayload, *projectRoleActorsAPIModel] {
+	return CRUDHooks[projectRoleActorsResourceModel, *projectRoleActorsPayload, *projectRoleActorsAPIModel]{
+		BuildPayload: func(ctx context.Context, st *projectRoleActorsResourceModel) (*projectRoleActorsPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			_, roleID, err := parseProjectRoleActorsKey(st.projectRoleActorsKey())
+			if err != nil {
+				diags.AddError("Invalid project role", err.Error())
+				return nil, diags
+			}
+			return &projectRoleActorsPayload{
+				Project: st.Project.ValueString(),
+				RoleID:  roleID,
+				Mode:    st.Mode.ValueString(),
+				Users:   setStrings(ctx, st.Users, &diags),
+				Groups:  setStrings(ctx, st.Groups, &diags),
+			}, diags
+		},
+		APICreate:  r.createActors,
+		APIRead:    r.getActors,
+		APIUpdate:  r.updateActors,
+		ExtractID:  func(st *projectRoleActorsResourceModel) string { return st.projectRoleActorsKey() },
+		MapToState: mapProjectRoleActorsToModel,
+		PostCreate: managedActors,
+		PostRead:   managedActors,
+		PostUpdate: managedActors,
+	}
+}
+
+func (r *projectRoleActorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *projectRoleActorsResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectRoleActorsResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoleActorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *projectRoleActorsResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectRoleActorsResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoleActorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	var state, plan projectRoleActorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if removesManagedOnUpdate(state.Mode.ValueString(), plan.Mode.ValueString()) {
+		rs, err := r.removeManaged(ctx, &state, &plan)
+		if !ensureWith(&resp.Diagnostics)(ctx, "remove project role actors", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+	}
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *projectRoleActorsResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectRoleActorsResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the users and groups in state. The generic runner only passes the ID to its delete hook, while
+// which members to remove depends on the state.
+func (r *projectRoleActorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	var state projectRoleActorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rs, err := r.removeManaged(ctx, &state, nil)
+	ensureWith(&resp.Diagnostics)(ctx, "remove project role actors", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true})
+}
+
+func (r *projectRoleActorsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	if _, _, err := parseProjectRoleActorsKey(request.ID); err != nil {
+		response.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected an import ID of the form project/role_id, for example PROJ/10002: %s", err))
+		return
+	}
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *projectRoleActorsResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
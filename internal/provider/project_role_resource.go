@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*projectRoleResource)(nil)
+var _ resource.ResourceWithConfigure = (*projectRoleResource)(nil)
+var _ resource.ResourceWithImportState = (*projectRoleResource)(nil)
+
+// NewProjectRoleResource returns the Terraform resource implementation for jira_project_role.
+func NewProjectRoleResource() resource.Resource { return &projectRoleResource{} }
+
+type projectRoleResource struct {
+	ServiceClient
+	roleService jira.ProjectRoleConnector
+	crudRunner  CRUDRunner[projectRoleResourceModel, *models.ProjectRolePayloadScheme, *models.ProjectRoleScheme]
+}
+
+func (r *projectRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_role"
+}
+
+func (r *projectRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.roleService = provider.client.Project.Role
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *projectRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a global Jira project role. Every company-managed project has the role; assign users and groups to it per project with `jira_project_role_actors`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the project role. Automatically generated by Jira when the role is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the project role. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the project role.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource. go-atlassian only creates and lists global
+// roles, so reads, updates and deletes are sent directly.
+func (r *projectRoleResource) createRole(ctx context.Context, p *models.ProjectRolePayloadScheme) (*models.ProjectRoleScheme, *models.ResponseScheme, error) {
+	return r.roleService.Create(ctx, p)
+}
+
+func (r *projectRoleResource) getRole(ctx context.Context, id string) (*models.ProjectRoleScheme, *models.ResponseScheme, error) {
+	var role models.ProjectRoleScheme
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/role/%s", id), nil, &role)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &role, rs, nil
+}
+
+// updateRole fully updates the role, so an empty description clears it.
+func (r *projectRoleResource) updateRole(ctx context.Context, id string, p *models.ProjectRolePayloadScheme) (*models.ProjectRoleScheme, *models.ResponseScheme, error) {
+	body := map[string]string{"name": p.Name, "description": p.Description}
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/role/%s", id), body, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getRole(ctx, id)
+}
+
+func (r *projectRoleResource) deleteRole(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/role/%s", id), nil, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *projectRoleResource) hooks() CRUDHooks[projectRoleResourceModel, *models.ProjectRolePayloadScheme, *models.ProjectRoleScheme] {
+	return CRUDHooks[projectRoleResourceModel, *models.ProjectRolePayloadScheme, *models.ProjectRoleScheme]{
+		BuildPayload: func(_ context.Context, st *projectRoleResourceModel) (*models.ProjectRolePayloadScheme, diag.Diagnostics) {
+			return &models.ProjectRolePayloadScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}, nil
+		},
+		APICreate:               r.createRole,
+		APIRead:                 r.getRole,
+		APIUpdate:               r.updateRole,
+		APIDelete:               r.deleteRole,
+		ExtractID:               func(st *projectRoleResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapProjectRoleToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *projectRoleResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectRoleResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *projectRoleResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectRoleResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *projectRoleResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectRoleResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *projectRoleResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRoleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *projectRoleResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectRoleResource_basic(t *testing.T) {
+	t.Parallel()
+
+	roleName := "jira_project_role.test"
+	actorsName := "jira_project_role_actors.test"
+	key := randomProjectKey(6)
+	name := strings.ReplaceAll(acctest.RandomWithPrefix(accPrefixProjectRole), "_", "-")
+	leadAccountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+	sameRoleID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetProjectRoleCfg(t, testhelpers.ProjectRoleTmplCfg{
+					ProjectKey:    key,
+					Name:          name,
+					LeadAccountID: leadAccountID,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(roleName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					sameRoleID.AddStateValue(roleName, tfjsonpath.New("id")),
+					statecheck.CompareValuePairs(actorsName, tfjsonpath.New("role_id"), roleName, tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(actorsName, tfjsonpath.New("mode"), knownvalue.StringExact(membershipModeAdditive)),
+					statecheck.ExpectKnownValue(actorsName, tfjsonpath.New("users"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(leadAccountID),
+					})),
+					statecheck.ExpectKnownValue(actorsName, tfjsonpath.New("groups"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetProjectRoleCfg(t, testhelpers.ProjectRoleTmplCfg{
+					ProjectKey:    key,
+					Name:          name,
+					Description:   "Updated project role description",
+					LeadAccountID: leadAccountID,
+					Mode:          membershipModeAuthoritative,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(roleName, tfjsonpath.New("description"), knownvalue.StringExact("Updated project role description")),
+					sameRoleID.AddStateValue(roleName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(actorsName, tfjsonpath.New("mode"), knownvalue.StringExact(membershipModeAuthoritative)),
+					statecheck.ExpectKnownValue(actorsName, tfjsonpath.New("users"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(leadAccountID),
+					})),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    roleName,
+			},
+			{
+				// An import starts additive with no managed actors, so only the addressing attributes are verified.
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mode", "users", "groups"},
+				ResourceName:            actorsName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[actorsName]
+					if !ok {
+						return "", fmt.Errorf("resource %s not found in state", actorsName)
+					}
+					return rs.Primary.Attributes["project"] + "/" + rs.Primary.Attributes["role_id"], nil
+				},
+			},
+			{
+				ImportState:   true,
+				ResourceName:  actorsName,
+				ImportStateId: "not-an-actors-id",
+				ExpectError:   regexp.MustCompile(`Invalid import ID`),
+			},
+		},
+	})
+}
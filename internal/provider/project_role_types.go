@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectRoleResourceModel models the Terraform schema/state for jira_project_role.
+type projectRoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *projectRoleResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+	}
+}
+
+// mapProjectRoleToModel centralizes mapping for the project role resource and matches CRUDHooks MapToState signature.
+func mapProjectRoleToModel(_ context.Context, api *models.ProjectRoleScheme, st *projectRoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no project role payload to map into state.")
+		return diags
+	}
+	*st = projectRoleResourceModel{
+		ID:          types.StringValue(strconv.Itoa(api.ID)),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+	}
+	return diags
+}
+
+// projectRoleActorsResourceModel models the Terraform schema/state for jira_project_role_actors.
+type projectRoleActorsResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+	RoleID  types.String `tfsdk:"role_id"`
+	Mode    types.String `tfsdk:"mode"`
+	Users   types.Set    `tfsdk:"users"`
+	Groups  types.Set    `tfsdk:"groups"`
+}
+
+// members returns the members in the model by kind.
+func (m *projectRoleActorsResourceModel) members(ctx context.Context) (membersByKind, error) {
+	return membersFromState(ctx, map[string]types.Set{memberKindUsers: m.Users, memberKindGroups: m.Groups})
+}
+
+func (m *projectRoleActorsResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":      types.StringType,
+		"project": types.StringType,
+		"role_id": types.StringType,
+		"mode":    types.StringType,
+		"users":   types.SetType{ElemType: types.StringType},
+		"groups":  types.SetType{ElemType: types.StringType},
+	}
+}
+
+// projectRoleActorsKey returns the "project/role_id" key the actors are addressed by; it is also the import ID.
+func (m *projectRoleActorsResourceModel) projectRoleActorsKey() string {
+	return m.Project.ValueString() + "/" + m.RoleID.ValueString()
+}
+
+// parseProjectRoleActorsKey splits a "project/role_id" key into the project ID or key and the numeric role ID.
+func parseProjectRoleActorsKey(key string) (project string, roleID int, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", 0, fmt.Errorf("invalid project role actors id %q: expected format project/role_id", key)
+	}
+	if roleID, err = strconv.Atoi(parts[1]); err != nil {
+		return "", 0, fmt.Errorf("invalid project role id %q: %w", parts[1], err)
+	}
+	return parts[0], roleID, nil
+}
+
+// projectRoleActorsPayload carries the planned actors for create/update. go-atlassian addresses actors through
+// positional arguments rather than a payload type, so the provider defines its own.
+type projectRoleActorsPayload struct {
+	Project string
+	RoleID  int
+	Mode    string
+	Users   []string
+	Groups  []string
+}
+
+// projectRoleActorsAPIModel is the actors of a role in a project, split into account IDs and group names.
+type projectRoleActorsAPIModel struct {
+	Project string
+	RoleID  int
+	Users   []string
+	Groups  []string
+}
+
+// newProjectRoleActorsAPIModel sorts the actors of a role into users and groups.
+func newProjectRoleActorsAPIModel(project string, role *models.ProjectRoleScheme) *projectRoleActorsAPIModel {
+	api := &projectRoleActorsAPIModel{Project: project, RoleID: role.ID}
+	for _, a := range role.Actors {
+		switch {
+		case a == nil:
+		case a.ActorUser != nil && a.ActorUser.AccountID != "":
+			api.Users = append(api.Users, a.ActorUser.AccountID)
+		case a.ActorGroup != nil && a.ActorGroup.Name != "":
+			api.Groups = append(api.Groups, a.ActorGroup.Name)
+		}
+	}
+	sort.Strings(api.Users)
+	sort.Strings(api.Groups)
+	return api
+}
+
+// mapProjectRoleActorsToModel centralizes mapping for the project role actors resource and matches CRUDHooks
+// MapToState signature. The mode is not stored in Jira, so it is carried over from st; an import starts additive.
+func mapProjectRoleActorsToModel(ctx context.Context, api *projectRoleActorsAPIModel, st *projectRoleActorsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no project role actors payload to map into state.")
+		return diags
+	}
+	mode := st.Mode
+	if mode.IsNull() || mode.IsUnknown() {
+		mode = types.StringValue(membershipModeAdditive)
+	}
+	users, d := stringSetOrNull(ctx, api.Users)
+	diags.Append(d...)
+	groups, d := stringSetOrNull(ctx, api.Groups)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = projectRoleActorsResourceModel{
+		ID:      types.StringValue(fmt.Sprintf("%s/%d", api.Project, api.RoleID)),
+		Project: types.StringValue(api.Project),
+		RoleID:  types.StringValue(strconv.Itoa(api.RoleID)),
+		Mode:    mode,
+		Users:   users,
+		Groups:  groups,
+	}
+	return diags
+}
@@ -50,3 +50,68 @@ func mapProjectSchemeToModel(_ context.Context, api *models.ProjectScheme, st *p
 	}
 	return diags
 }
+
+// projectSchemeAssociationsModel holds the scheme IDs associated with a project. It is kept apart from
+// projectResourceModel because jira_projects lists projects without looking up their associations.
+type projectSchemeAssociationsModel struct {
+	WorkflowSchemeID           types.String `tfsdk:"workflow_scheme_id"`
+	IssueTypeSchemeID          types.String `tfsdk:"issue_type_scheme_id"`
+	IssueTypeScreenSchemeID    types.String `tfsdk:"issue_type_screen_scheme_id"`
+	FieldConfigurationSchemeID types.String `tfsdk:"field_configuration_scheme_id"`
+	PermissionSchemeID         types.String `tfsdk:"permission_scheme_id"`
+	NotificationSchemeID       types.String `tfsdk:"notification_scheme_id"`
+	IssueSecuritySchemeID      types.String `tfsdk:"issue_security_scheme_id"`
+}
+
+// projectWithSchemesResourceModel models the Terraform schema/state for jira_project.
+type projectWithSchemesResourceModel struct {
+	projectResourceModel
+	projectSchemeAssociationsModel
+}
+
+// projectSchemeIDs carries the association IDs read from Jira; an empty string means no (or the default, ID-less) scheme.
+type projectSchemeIDs struct {
+	Workflow           string
+	IssueType          string
+	IssueTypeScreen    string
+	FieldConfiguration string
+	Permission         string
+	Notification       string
+	IssueSecurity      string
+}
+
+// projectAPIModel wraps the go-atlassian project model with the scheme associations,
+// which Jira only exposes through separate per-scheme endpoints.
+type projectAPIModel struct {
+	*models.ProjectScheme
+	SchemeIDs projectSchemeIDs
+}
+
+// mapProjectAPIModelToModel maps a project and its scheme associations and matches CRUDHooks MapToState signature.
+func mapProjectAPIModelToModel(ctx context.Context, api *projectAPIModel, st *projectWithSchemesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.ProjectScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no project payload to map into state.")
+		return diags
+	}
+
+	var project projectResourceModel
+	diags.Append(mapProjectSchemeToModel(ctx, api.ProjectScheme, &project)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = projectWithSchemesResourceModel{
+		projectResourceModel: project,
+		projectSchemeAssociationsModel: projectSchemeAssociationsModel{
+			WorkflowSchemeID:           stringOrNull(api.SchemeIDs.Workflow),
+			IssueTypeSchemeID:          stringOrNull(api.SchemeIDs.IssueType),
+			IssueTypeScreenSchemeID:    stringOrNull(api.SchemeIDs.IssueTypeScreen),
+			FieldConfigurationSchemeID: stringOrNull(api.SchemeIDs.FieldConfiguration),
+			PermissionSchemeID:         stringOrNull(api.SchemeIDs.Permission),
+			NotificationSchemeID:       stringOrNull(api.SchemeIDs.Notification),
+			IssueSecuritySchemeID:      stringOrNull(api.SchemeIDs.IssueSecurity),
+		},
+	}
+	return diags
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*projectVersionResource)(nil)
+var _ resource.ResourceWithConfigure = (*projectVersionResource)(nil)
+var _ resource.ResourceWithImportState = (*projectVersionResource)(nil)
+
+// NewProjectVersionResource returns the Terraform resource implementation for jira_project_version.
+func NewProjectVersionResource() resource.Resource { return &projectVersionResource{} }
+
+type projectVersionResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[projectVersionResourceModel, *projectVersionPayload, *projectVersionAPIModel]
+}
+
+func (r *projectVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_version"
+}
+
+func (r *projectVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *projectVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a version (release) of a Jira project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the version. Automatically generated by Jira when the version is created.",
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the project the version belongs to. Changing this forces a new version.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the version. Must be unique within the project.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the version.",
+			},
+			"start_date": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          jiraDateType{},
+				MarkdownDescription: "The start date of the version, as `YYYY-MM-DD` or an RFC 3339 timestamp. Jira stores the day only.",
+			},
+			"release_date": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          jiraDateType{},
+				MarkdownDescription: "The release date of the version, as `YYYY-MM-DD` or an RFC 3339 timestamp. Jira stores the day only.",
+			},
+			"released": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the version is released. Defaults to `false`.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the version is archived. Defaults to `false`.",
+			},
+			"move_unfixed_issues_to": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "ID of the version that unresolved work items with this fix version are moved to when the version is " +
+					"released. Only used when `released` changes to `true`.",
+				Validators: []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric version ID")},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the version endpoints. Requests are sent directly (see projectVersionPayload).
+func (r *projectVersionResource) createVersion(ctx context.Context, p *projectVersionPayload) (*projectVersionAPIModel, *models.ResponseScheme, error) {
+	// A new version has no work items to move.
+	body := *p
+	body.MoveUnfixedIssuesTo = ""
+	var created projectVersionAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/version", &body, &created)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &created, rs, nil
+}
+
+func (r *projectVersionResource) getVersion(ctx context.Context, id string) (*projectVersionAPIModel, *models.ResponseScheme, error) {
+	var version projectVersionAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/version/%s", id), nil, &version)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &version, rs, nil
+}
+
+// updateVersion updates the version. Unfixed work items are only moved by the update that releases the version, so
+// later updates of a released version leave its work items alone.
+func (r *projectVersionResource) updateVersion(ctx context.Context, id string, p *projectVersionPayload) (*projectVersionAPIModel, *models.ResponseScheme, error) {
+	body := *p
+	body.ProjectID = 0
+	if body.MoveUnfixedIssuesTo != "" {
+		current, rs, err := r.getVersion(ctx, id)
+		if err != nil {
+			return nil, rs, err
+		}
+		if current.Released || !body.Released {
+			body.MoveUnfixedIssuesTo = ""
+		} else {
+			// Jira identifies the target version by its self link.
+			body.MoveUnfixedIssuesTo = r.client.Site.JoinPath("rest/api/3/version", body.MoveUnfixedIssuesTo).String()
+		}
+	}
+	var updated projectVersionAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/version/%s", id), &body, &updated)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &updated, rs, nil
+}
+
+// deleteVersion removes the version through removeAndSwap, which replaces the deprecated delete endpoint. Without
+// swap targets, the version is simply removed from its work items.
+func (r *projectVersionResource) deleteVersion(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodPost, fmt.Sprintf("rest/api/3/version/%s/removeAndSwap", id), map[string]any{}, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *projectVersionResource) hooks() CRUDHooks[projectVersionResourceModel, *projectVersionPayload, *projectVersionAPIModel] {
+	return CRUDHooks[projectVersionResourceModel, *projectVersionPayload, *projectVersionAPIModel]{
+		BuildPayload: func(_ context.Context, st *projectVersionResourceModel) (*projectVersionPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			projectID, err := strconv.Atoi(st.ProjectID.ValueString())
+			if err != nil {
+				diags.AddError("Invalid project ID", fmt.Sprintf("Project ID %q is not numeric: %s", st.ProjectID.ValueString(), err))
+				return nil, diags
+			}
+			return &projectVersionPayload{
+				Name:                st.Name.ValueString(),
+				Description:         st.Description.ValueString(),
+				ProjectID:           projectID,
+				StartDate:           st.StartDate.jiraDate(),
+				ReleaseDate:         st.ReleaseDate.jiraDate(),
+				Released:            st.Released.ValueBool(),
+				Archived:            st.Archived.ValueBool(),
+				MoveUnfixedIssuesTo: st.MoveUnfixedIssuesTo.ValueString(),
+			}, diags
+		},
+		APICreate:               r.createVersion,
+		APIRead:                 r.getVersion,
+		APIUpdate:               r.updateVersion,
+		APIDelete:               r.deleteVersion,
+		ExtractID:               func(st *projectVersionResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapProjectVersionToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *projectVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *projectVersionResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectVersionResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *projectVersionResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectVersionResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *projectVersionResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *projectVersionResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *projectVersionResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectVersionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *projectVersionResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
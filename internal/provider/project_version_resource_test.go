@@ -0,0 +1,120 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectVersionResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_project_version.test"
+	key := randomProjectKey(6)
+	name := strings.ReplaceAll(acctest.RandomWithPrefix(accPrefixVersion), "_", "-")
+	leadAccountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	scheduled := testhelpers.ProjectVersionTmplCfg{
+		ProjectKey:    key,
+		Name:          name,
+		LeadAccountID: leadAccountID,
+		StartDate:     "2030-01-06T09:00:00Z",
+		ReleaseDate:   "2030-02-14",
+	}
+	released := scheduled
+	released.Released = true
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetProjectVersionCfg(t, scheduled),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("start_date"), knownvalue.StringExact("2030-01-06T09:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("release_date"), knownvalue.StringExact("2030-02-14")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("released"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("archived"), knownvalue.Bool(false)),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New("project_id"), "jira_project.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+				},
+			},
+			{
+				// The timestamp start date matches the day Jira stores, so re-planning shows no changes.
+				Config: testhelpers.GetProjectVersionCfg(t, scheduled),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			{
+				Config: testhelpers.GetProjectVersionCfg(t, released),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("released"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				// Removing the dates clears them in Jira.
+				Config: testhelpers.GetProjectVersionCfg(t, testhelpers.ProjectVersionTmplCfg{ProjectKey: key, Name: name, LeadAccountID: leadAccountID, Released: true}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("start_date"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("release_date"), knownvalue.Null()),
+				},
+			},
+			{
+				// The version unfixed work items move to only exists in configuration and is not imported.
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"move_unfixed_issues_to"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestJiraDateSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		have string
+		want string
+		same bool
+	}{
+		{name: "same date", have: "2030-02-14", want: "2030-02-14", same: true},
+		{name: "timestamp and date", have: "2030-02-14T09:30:00Z", want: "2030-02-14", same: true},
+		{name: "timestamp keeps its own day", have: "2030-02-14T23:00:00-05:00", want: "2030-02-14", same: true},
+		{name: "different day", have: "2030-02-14T00:00:00Z", want: "2030-02-15", same: false},
+		{name: "invalid", have: "14/02/2030", want: "2030-02-14", same: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			have := jiraDateValue{StringValue: types.StringValue(tc.have)}
+			want := jiraDateValue{StringValue: types.StringValue(tc.want)}
+			same, diags := have.StringSemanticEquals(context.Background(), want)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if same != tc.same {
+				t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tc.have, tc.want, same, tc.same)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectVersionResourceModel models the Terraform schema/state for jira_project_version.
+type projectVersionResourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	ProjectID           types.String  `tfsdk:"project_id"`
+	Name                types.String  `tfsdk:"name"`
+	Description         types.String  `tfsdk:"description"`
+	StartDate           jiraDateValue `tfsdk:"start_date"`
+	ReleaseDate         jiraDateValue `tfsdk:"release_date"`
+	Released            types.Bool    `tfsdk:"released"`
+	Archived            types.Bool    `tfsdk:"archived"`
+	MoveUnfixedIssuesTo types.String  `tfsdk:"move_unfixed_issues_to"`
+}
+
+// projectVersionPayload carries the planned version for create/update. go-atlassian omits empty and false
+// properties, so it could neither clear a date nor unrelease a version; the provider defines its own request type.
+// Dates are sent as null to clear them.
+type projectVersionPayload struct {
+	Name                string  `json:"name"`
+	Description         string  `json:"description"`
+	ProjectID           int     `json:"projectId,omitempty"`
+	StartDate           *string `json:"startDate"`
+	ReleaseDate         *string `json:"releaseDate"`
+	Released            bool    `json:"released"`
+	Archived            bool    `json:"archived"`
+	MoveUnfixedIssuesTo string  `json:"moveUnfixedIssuesTo,omitempty"`
+}
+
+// projectVersionAPIModel is the version as returned by Jira. go-atlassian's VersionScheme has no start date.
+type projectVersionAPIModel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectID   int    `json:"projectId"`
+	StartDate   string `json:"startDate"`
+	ReleaseDate string `json:"releaseDate"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+}
+
+// mapProjectVersionToModel centralizes mapping for the version resource and matches CRUDHooks MapToState signature.
+// The version unfixed work items move to only exists in configuration and is carried over from st.
+func mapProjectVersionToModel(_ context.Context, api *projectVersionAPIModel, st *projectVersionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no version payload to map into state.")
+		return diags
+	}
+	*st = projectVersionResourceModel{
+		ID:                  types.StringValue(api.ID),
+		ProjectID:           types.StringValue(strconv.Itoa(api.ProjectID)),
+		Name:                types.StringValue(api.Name),
+		Description:         stringOrNull(api.Description),
+		StartDate:           newJiraDateValue(api.StartDate),
+		ReleaseDate:         newJiraDateValue(api.ReleaseDate),
+		Released:            types.BoolValue(api.Released),
+		Archived:            types.BoolValue(api.Archived),
+		MoveUnfixedIssuesTo: st.MoveUnfixedIssuesTo,
+	}
+	return diags
+}
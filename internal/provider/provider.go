@@ -34,6 +34,8 @@ type JiraProvider struct {
 	client *jira.Client
 	// provider-level operation timeouts
 	providerTimeouts opTimeouts
+	// emailRedactionMode is the resolved email_redaction_mode, applied to emails in diagnostics.
+	emailRedactionMode string
 }
 
 // JiraProviderModel describes the provider data model.
@@ -293,6 +295,7 @@ func (j *JiraProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	// Attach clients for resources/data sources
 	j.client = client
+	j.emailRedactionMode = rc.emailRedactionMode
 	resp.ResourceData = j
 	resp.DataSourceData = j
 }
@@ -304,6 +307,38 @@ func (j *JiraProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewProjectResource,
 		NewProjectCategoryResource,
 		NewFieldResource,
+		NewWorkflowStatusResource,
+		NewWorkflowResource,
+		NewWorkflowSchemeResource,
+		NewIssueTypeSchemeResource,
+		NewScreenResource,
+		NewScreenTabResource,
+		NewScreenSchemeResource,
+		NewIssueTypeScreenSchemeResource,
+		NewFieldContextResource,
+		NewFieldContextOptionsResource,
+		NewFieldConfigurationResource,
+		NewFieldConfigurationSchemeResource,
+		NewPermissionSchemeResource,
+		NewNotificationSchemeResource,
+		NewIssueSecuritySchemeResource,
+		NewIssueSecurityLevelResource,
+		NewProjectRoleResource,
+		NewProjectRoleActorsResource,
+		NewGroupResource,
+		NewGroupMembershipResource,
+		NewPriorityResource,
+		NewPrioritySchemeResource,
+		NewResolutionResource,
+		NewIssueLinkTypeResource,
+		NewProjectComponentResource,
+		NewProjectVersionResource,
+		NewFilterResource,
+		NewDashboardResource,
+		NewBoardResource,
+		NewWebhookResource,
+		NewAnnouncementBannerResource,
+		NewApplicationPropertyResource,
 	}
 }
 
@@ -314,6 +349,16 @@ func (j *JiraProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		NewProjectDataSource,
 		NewProjectsDataSource,
 		NewProjectCategoriesDataSource,
+		NewWorkflowStatusesDataSource,
+		NewPermissionsDataSource,
+		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewPrioritiesDataSource,
+		NewResolutionsDataSource,
+		NewIssueLinkTypesDataSource,
+		NewBoardsDataSource,
 	}
 }
 
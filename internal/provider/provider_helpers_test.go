@@ -109,6 +109,16 @@ func TestRedactSecrets_BasicCases(t *testing.T) {
 			notHave: []string{"api_token: abc12345"},
 			haveAny: []string{"api_token: <redacted>"},
 		},
+		{
+			in:      `{"name":"svc","url":"https://svc.example.com/hook","secret":"whsec-123"}`,
+			notHave: []string{"whsec-123"},
+			haveAny: []string{`"secret":"<redacted>"`},
+		},
+		{
+			in:      "https://svc.example.com/hook?secret=whsec-123",
+			notHave: []string{"whsec-123"},
+			haveAny: []string{"secret=<redacted>"},
+		},
 		{
 			in:      "Contact: john.doe@example.com",
 			notHave: []string{"john.doe@example.com"},
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*resolutionResource)(nil)
+var _ resource.ResourceWithConfigure = (*resolutionResource)(nil)
+var _ resource.ResourceWithImportState = (*resolutionResource)(nil)
+
+// NewResolutionResource returns the Terraform resource implementation for jira_resolution.
+func NewResolutionResource() resource.Resource { return &resolutionResource{} }
+
+type resolutionResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[resolutionResourceModel, *resolutionPayload, *resolutionAPIModel]
+}
+
+func (r *resolutionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolution"
+}
+
+func (r *resolutionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *resolutionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira resolution, the outcome recorded when a work item is closed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the resolution. Automatically generated by Jira when the resolution is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the resolution. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 60)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the resolution.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the resolution is the site default, preselected when resolving work items. Only one resolution should set this. Defaults to `false`.",
+			},
+			"replacement_id": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "ID of the resolution that work items with this resolution are moved to when it is deleted. " +
+					"When omitted, they are moved to the site default resolution. Only used on delete.",
+				Validators: []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric resolution ID")},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the resolution endpoints, which go-atlassian does not wrap for writes.
+func (r *resolutionResource) createResolution(ctx context.Context, p *resolutionPayload) (*resolutionAPIModel, *models.ResponseScheme, error) {
+	var created resolutionAPIModel
+	if rs, err := callJira(ctx, r.client, http.MethodPost, "rest/api/3/resolution", p, &created); err != nil {
+		return nil, rs, err
+	}
+	if p.IsDefault {
+		if rs, err := r.setDefault(ctx, created.ID); err != nil {
+			return nil, rs, err
+		}
+	}
+	return r.getResolution(ctx, created.ID)
+}
+
+// getResolution reads the resolution through the search endpoint, the only one that reports whether it is the default.
+func (r *resolutionResource) getResolution(ctx context.Context, id string) (*resolutionAPIModel, *models.ResponseScheme, error) {
+	var page resolutionPage
+	rs, err := callJira(ctx, r.client, http.MethodGet, "rest/api/3/resolution/search?"+url.Values{"id": {id}}.Encode(), nil, &page)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, v := range page.Values {
+		if v != nil && v.ID == id {
+			return v, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("resolution %s not found", id)
+}
+
+// updateResolution updates the resolution and moves the site default to or away from it.
+func (r *resolutionResource) updateResolution(ctx context.Context, id string, p *resolutionPayload) (*resolutionAPIModel, *models.ResponseScheme, error) {
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/resolution/%s", id), p, nil); err != nil {
+		return nil, rs, err
+	}
+	current, rs, err := r.getResolution(ctx, id)
+	if err != nil || current.IsDefault == p.IsDefault {
+		return current, rs, err
+	}
+	// As for priorities, the default is only cleared while this resolution still holds it.
+	var defaultID *string
+	if p.IsDefault {
+		defaultID = &id
+	}
+	if rs, err := r.setDefault(ctx, defaultID); err != nil {
+		return nil, rs, err
+	}
+	return r.getResolution(ctx, id)
+}
+
+// setDefault makes the resolution the site default; nil clears the default.
+func (r *resolutionResource) setDefault(ctx context.Context, id any) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodPut, "rest/api/3/resolution/default", map[string]any{"id": id}, nil)
+}
+
+// defaultResolutionID returns the site default resolution, which work items move to when a resolution is deleted
+// without a configured replacement. It fails when there is no default or when the default is id itself.
+func (r *resolutionResource) defaultResolutionID(ctx context.Context, id string) (string, *models.ResponseScheme, error) {
+	var page resolutionPage
+	rs, err := callJira(ctx, r.client, http.MethodGet, "rest/api/3/resolution/search?"+url.Values{"onlyDefault": {"true"}}.Encode(), nil, &page)
+	if err != nil {
+		return "", rs, err
+	}
+	for _, v := range page.Values {
+		if v != nil && v.IsDefault && v.ID != id {
+			return v.ID, rs, nil
+		}
+	}
+	return "", rs, errors.New("there is no other default resolution to move work items to; set replacement_id")
+}
+
+// deleteResolution removes the resolution, moving its work items to replaceWith. Jira does this asynchronously and
+// redirects to the task, which is awaited within the delete timeout.
+func (r *resolutionResource) deleteResolution(ctx context.Context, id, replaceWith string) (*models.ResponseScheme, error) {
+	var task models.TaskScheme
+	endpoint := fmt.Sprintf("rest/api/3/resolution/%s?%s", id, url.Values{"replaceWith": {replaceWith}}.Encode())
+	rs, err := callJira(ctx, r.client, http.MethodDelete, endpoint, nil, &task)
+	if err != nil || task.ID == "" {
+		return rs, err
+	}
+	_, rs, err = waitForJiraTask(ctx, r.client, task.ID, "delete resolution")
+	return rs, err
+}
+
+// hooks returns the CRUD hooks for the generic runner. Delete is handled by the resource, because it needs the
+// replacement resolution from state.
+func (r *resolutionResource) hooks() CRUDHooks[resolutionResourceModel, *resolutionPayload, *resolutionAPIModel] {
+	return CRUDHooks[resolutionResourceModel, *resolutionPayload, *resolutionAPIModel]{
+		BuildPayload: func(_ context.Context, st *resolutionResourceModel) (*resolutionPayload, diag.Diagnostics) {
+			return &resolutionPayload{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+				IsDefault:   st.IsDefault.ValueBool(),
+			}, nil
+		},
+		APICreate:  r.createResolution,
+		APIRead:    r.getResolution,
+		APIUpdate:  r.updateResolution,
+		ExtractID:  func(st *resolutionResourceModel) string { return st.ID.ValueString() },
+		MapToState: mapResolutionToModel,
+	}
+}
+
+func (r *resolutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *resolutionResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *resolutionResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *resolutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *resolutionResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *resolutionResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *resolutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *resolutionResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *resolutionResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *resolutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	var state resolutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	replaceWith := state.ReplacementID.ValueString()
+	if replaceWith == id {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("replacement_id"),
+			"Invalid replacement resolution",
+			fmt.Sprintf("Resolution %s cannot replace itself. Set 'replacement_id' to another resolution before destroying it.", id),
+		)
+		return
+	}
+	if replaceWith == "" {
+		defaultID, rs, err := r.defaultResolutionID(ctx, id)
+		if !ensureWith(&resp.Diagnostics)(ctx, "find replacement resolution", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+		replaceWith = defaultID
+	}
+
+	rs, err := r.deleteResolution(ctx, id, replaceWith)
+	// Already gone: nothing left to delete.
+	if HTTPStatusFromScheme(rs) == http.StatusNotFound {
+		return
+	}
+	ensureWith(&resp.Diagnostics)(ctx, "delete resolution", rs, err, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true})
+}
+
+func (r *resolutionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *resolutionResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccResolutionResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_resolution.test"
+	fallbackName := "jira_resolution.fallback"
+	name := acctest.RandomWithPrefix(accPrefixResolution)
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetResolutionCfg(t, testhelpers.ResolutionTmplCfg{Name: name, Description: "Created by acceptance tests"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Created by acceptance tests")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("is_default"), knownvalue.Bool(false)),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New("replacement_id"), fallbackName, tfjsonpath.New("id"), compare.ValuesSame()),
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("data.jira_resolutions.all", tfjsonpath.New("resolutions"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testhelpers.GetResolutionCfg(t, testhelpers.ResolutionTmplCfg{Name: name + "-renamed", Description: "Updated by acceptance tests"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(name+"-renamed")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Updated by acceptance tests")),
+				},
+			},
+			{
+				// The replacement resolution only exists in configuration and is not imported.
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replacement_id"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resolutionResourceModel models the Terraform schema/state for jira_resolution.
+type resolutionResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	IsDefault     types.Bool   `tfsdk:"is_default"`
+	ReplacementID types.String `tfsdk:"replacement_id"`
+}
+
+// resolutionModel is the element type of the resolutions list of the jira_resolutions data source.
+type resolutionModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+func (m *resolutionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"is_default":  types.BoolType,
+	}
+}
+
+// resolutionPayload carries the planned resolution for create/update. go-atlassian only reads resolutions, so the
+// provider defines its own request and response types.
+type resolutionPayload struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"-"`
+}
+
+// resolutionAPIModel is the resolution as returned by the resolution search endpoint, which also reports the default.
+type resolutionAPIModel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"isDefault"`
+}
+
+// resolutionPage is a page of resolutions as returned by Jira, in resolution order.
+type resolutionPage struct {
+	IsLast bool                  `json:"isLast"`
+	Values []*resolutionAPIModel `json:"values"`
+}
+
+// mapResolutionToModel centralizes mapping for the resolution resource and matches CRUDHooks MapToState signature.
+// The replacement resolution only exists in configuration and is carried over from st.
+func mapResolutionToModel(_ context.Context, api *resolutionAPIModel, st *resolutionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no resolution payload to map into state.")
+		return diags
+	}
+	*st = resolutionResourceModel{
+		ID:            types.StringValue(api.ID),
+		Name:          types.StringValue(api.Name),
+		Description:   stringOrNull(api.Description),
+		IsDefault:     types.BoolValue(api.IsDefault),
+		ReplacementID: st.ReplacementID,
+	}
+	return diags
+}
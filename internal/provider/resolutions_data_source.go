@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*resolutionsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*resolutionsDataSource)(nil)
+
+// resolutionsPageSize is the page size used when paging through the resolution search endpoint.
+const resolutionsPageSize = 50
+
+// NewResolutionsDataSource returns the Terraform data source implementation for jira_resolutions.
+func NewResolutionsDataSource() datasource.DataSource { return &resolutionsDataSource{} }
+
+type resolutionsDataSource struct {
+	ServiceClient
+}
+
+type resolutionsDataSourceModel struct {
+	Resolutions types.List `tfsdk:"resolutions"`
+}
+
+func (d *resolutionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolutions"
+}
+
+func (d *resolutionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all Jira resolutions in the order Jira shows them.",
+		Attributes: map[string]schema.Attribute{
+			"resolutions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of resolutions in resolution order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the resolution.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the resolution.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the resolution.",
+						},
+						"is_default": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the resolution is the site default.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resolutionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *resolutionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data resolutionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list keeps Jira's order, so the map-based list helpers do not apply here.
+	resolutions := []resolutionModel{}
+	for startAt := 0; ; startAt += resolutionsPageSize {
+		var page resolutionPage
+		query := url.Values{"startAt": {strconv.Itoa(startAt)}, "maxResults": {strconv.Itoa(resolutionsPageSize)}}.Encode()
+		apiResp, err := callJira(ctx, d.client, http.MethodGet, "rest/api/3/resolution/search?"+query, nil, &page)
+		if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "search resolutions", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+		for _, v := range page.Values {
+			if v == nil {
+				continue
+			}
+			resolutions = append(resolutions, resolutionModel{
+				ID:          types.StringValue(v.ID),
+				Name:        types.StringValue(v.Name),
+				Description: stringOrNull(v.Description),
+				IsDefault:   types.BoolValue(v.IsDefault),
+			})
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, lDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&resolutionModel{}).AttributeTypes()}, resolutions)
+	if lDiags.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resolutions"),
+			"Failed to build resolutions list",
+			fmt.Sprintf("Could not encode %d resolutions into state. See diagnostics for details.", len(resolutions)),
+		)
+		resp.Diagnostics.Append(lDiags...)
+		return
+	}
+	data.Resolutions = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
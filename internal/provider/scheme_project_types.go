@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// schemeProjectAssociation is one page entry of a scheme's project associations: the scheme and the projects using it.
+type schemeProjectAssociation struct {
+	SchemeID   string
+	ProjectIDs []string
+}
+
+// schemeProjectAssignment manages the project_ids of a scheme that a classic project uses exactly one of, such as
+// issue type schemes (jira_issue_type_scheme) and issue type screen schemes (jira_issue_type_screen_scheme). The
+// callbacks adapt the go-atlassian service of the scheme type.
+type schemeProjectAssignment struct {
+	// pageSize is the page size used when listing project associations.
+	pageSize int
+	// list returns a page of the associations of the given projects and whether it is the last page.
+	list func(ctx context.Context, projectIDs []int, startAt, maxResults int) ([]schemeProjectAssociation, bool, *models.ResponseScheme, error)
+	// assign makes the project use the scheme.
+	assign func(ctx context.Context, schemeID, projectID string) (*models.ResponseScheme, error)
+	// defaultSchemeID returns the scheme that released projects are moved back to.
+	defaultSchemeID func(ctx context.Context) (string, *models.ResponseScheme, error)
+}
+
+// projectsUsingScheme returns the subset of projectIDs currently assigned to the scheme.
+func (a schemeProjectAssignment) projectsUsingScheme(ctx context.Context, schemeID string, projectIDs []string) ([]string, *models.ResponseScheme, error) {
+	ids := make([]int, 0, len(projectIDs))
+	for _, pid := range projectIDs {
+		n, err := strconv.Atoi(pid)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid project id %q: %w", pid, err)
+		}
+		ids = append(ids, n)
+	}
+
+	using := []string{}
+	rs := &models.ResponseScheme{Code: http.StatusOK}
+	if len(ids) == 0 {
+		return using, rs, nil
+	}
+	for startAt := 0; ; startAt += a.pageSize {
+		page, isLast, pageRS, err := a.list(ctx, ids, startAt, a.pageSize)
+		if err != nil {
+			return nil, pageRS, err
+		}
+		rs = pageRS
+		for _, v := range page {
+			if v.SchemeID != schemeID {
+				continue
+			}
+			for _, pid := range v.ProjectIDs {
+				if slices.Contains(projectIDs, pid) {
+					using = append(using, pid)
+				}
+			}
+		}
+		if isLast || len(page) == 0 {
+			break
+		}
+	}
+	return using, rs, nil
+}
+
+// syncProjects returns the managed projects that use the scheme. With assign set, it first assigns the planned
+// projects that do not use the scheme yet. When project assignment is unmanaged it returns nil without a request.
+func (a schemeProjectAssignment) syncProjects(ctx context.Context, schemeID string, projectIDs types.Set, assign bool) ([]string, *models.ResponseScheme, error) {
+	// Project assignment is unmanaged: nothing to request, report success.
+	if projectIDs.IsNull() || projectIDs.IsUnknown() {
+		return nil, &models.ResponseScheme{Code: http.StatusOK}, nil
+	}
+	var planned []string
+	if d := projectIDs.ElementsAs(ctx, &planned, false); d.HasError() {
+		return nil, nil, fmt.Errorf("reading project_ids: %s", d[0].Detail())
+	}
+
+	using, rs, err := a.projectsUsingScheme(ctx, schemeID, planned)
+	if err != nil {
+		return nil, rs, err
+	}
+	if assign {
+		for _, pid := range planned {
+			if slices.Contains(using, pid) {
+				continue
+			}
+			if rs, err := a.assign(ctx, schemeID, pid); err != nil {
+				return nil, rs, err
+			}
+			using = append(using, pid)
+		}
+	}
+	return using, rs, nil
+}
+
+// releaseProjects moves the projects in prior but not in planned back to the default scheme. A null planned set
+// releases every prior project.
+func (a schemeProjectAssignment) releaseProjects(ctx context.Context, schemeID string, prior, planned types.Set) (*models.ResponseScheme, error) {
+	rs := &models.ResponseScheme{Code: http.StatusOK}
+	if prior.IsNull() || planned.IsUnknown() {
+		return rs, nil
+	}
+	var priorIDs, plannedIDs []string
+	if d := prior.ElementsAs(ctx, &priorIDs, false); d.HasError() {
+		return nil, fmt.Errorf("reading project_ids: %s", d[0].Detail())
+	}
+	if !planned.IsNull() {
+		if d := planned.ElementsAs(ctx, &plannedIDs, false); d.HasError() {
+			return nil, fmt.Errorf("reading project_ids: %s", d[0].Detail())
+		}
+	}
+
+	var released []string
+	for _, pid := range priorIDs {
+		if !slices.Contains(plannedIDs, pid) {
+			released = append(released, pid)
+		}
+	}
+	if len(released) == 0 {
+		return rs, nil
+	}
+	// Only release projects that still use this scheme; others were already reassigned outside Terraform.
+	using, rs, err := a.projectsUsingScheme(ctx, schemeID, released)
+	if err != nil || len(using) == 0 {
+		return rs, err
+	}
+	defaultID, rs, err := a.defaultSchemeID(ctx)
+	if err != nil {
+		return rs, err
+	}
+	for _, pid := range using {
+		if rs, err = a.assign(ctx, defaultID, pid); err != nil {
+			return rs, err
+		}
+	}
+	return rs, nil
+}
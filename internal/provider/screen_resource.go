@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*screenResource)(nil)
+var _ resource.ResourceWithConfigure = (*screenResource)(nil)
+var _ resource.ResourceWithImportState = (*screenResource)(nil)
+
+// NewScreenResource returns the Terraform resource implementation for jira_screen.
+func NewScreenResource() resource.Resource { return &screenResource{} }
+
+type screenResource struct {
+	ServiceClient
+	screenService jira.ScreenConnector
+	crudRunner    CRUDRunner[screenResourceModel, *models.ScreenScheme, *models.ScreenScheme]
+}
+
+func (r *screenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_screen"
+}
+
+func (r *screenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.screenService = provider.client.Screen
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *screenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira screen, the layout of fields shown when creating, editing or viewing work items. Lay out the screen with `jira_screen_tab` resources.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the screen. Automatically generated by Jira when the screen is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the screen. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the screen.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *screenResource) createScreen(ctx context.Context, p *models.ScreenScheme) (*models.ScreenScheme, *models.ResponseScheme, error) {
+	return r.screenService.Create(ctx, p.Name, p.Description)
+}
+
+// getScreen looks the screen up by ID through the search endpoint; Jira has no single-screen GET.
+func (r *screenResource) getScreen(ctx context.Context, id string) (*models.ScreenScheme, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid screen id %q: %w", id, err)
+	}
+	page, rs, err := r.screenService.Gets(ctx, &models.ScreenParamsScheme{IDs: []int{i}}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, s := range page.Values {
+		if s != nil && s.ID == i {
+			return s, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("screen %s not found", id)
+}
+
+func (r *screenResource) updateScreen(ctx context.Context, id string, p *models.ScreenScheme) (*models.ScreenScheme, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid screen id %q: %w", id, err)
+	}
+	return r.screenService.Update(ctx, i, p.Name, p.Description)
+}
+
+func (r *screenResource) deleteScreen(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid screen id %q: %w", id, err)
+	}
+	return r.screenService.Delete(ctx, i)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *screenResource) hooks() CRUDHooks[screenResourceModel, *models.ScreenScheme, *models.ScreenScheme] {
+	return CRUDHooks[screenResourceModel, *models.ScreenScheme, *models.ScreenScheme]{
+		BuildPayload: func(ctx context.Context, st *screenResourceModel) (*models.ScreenScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			return &models.ScreenScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+			}, diags
+		},
+		APICreate:               r.createScreen,
+		APIRead:                 r.getScreen,
+		APIUpdate:               r.updateScreen,
+		APIDelete:               r.deleteScreen,
+		ExtractID:               func(st *screenResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapScreenSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *screenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *screenResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *screenResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *screenResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *screenResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *screenResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
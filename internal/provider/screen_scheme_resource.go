@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*screenSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*screenSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*screenSchemeResource)(nil)
+
+// NewScreenSchemeResource returns the Terraform resource implementation for jira_screen_scheme.
+func NewScreenSchemeResource() resource.Resource { return &screenSchemeResource{} }
+
+type screenSchemeResource struct {
+	ServiceClient
+	schemeService jira.ScreenSchemeConnector
+	crudRunner    CRUDRunner[screenSchemeResourceModel, *models.ScreenSchemePayloadScheme, *models.ScreenSchemeScheme]
+}
+
+func (r *screenSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_screen_scheme"
+}
+
+func (r *screenSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.schemeService = provider.client.Screen.Scheme
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+// screenSchemeScreenAttribute builds the optional per-operation screen ID attributes, which fall back to the
+// default screen when omitted.
+func screenSchemeScreenAttribute(operation string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("ID of the screen shown when %s a work item. When omitted, the default screen is used.", operation),
+		Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric screen ID")},
+	}
+}
+
+func (r *screenSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira screen scheme, which selects the screens shown when creating, editing or viewing work items. Map work types to screen schemes with `jira_issue_type_screen_scheme`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the screen scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the screen scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the screen scheme.",
+				Validators:          []validator.String{stringvalidator.LengthAtMost(255)},
+			},
+			"default_screen_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the screen used for every operation without its own screen (for example `jira_screen.example.id`).",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric screen ID")},
+			},
+			"create_screen_id": screenSchemeScreenAttribute("creating"),
+			"edit_screen_id":   screenSchemeScreenAttribute("editing"),
+			"view_screen_id":   screenSchemeScreenAttribute("viewing"),
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *screenSchemeResource) createScheme(ctx context.Context, p *models.ScreenSchemePayloadScheme) (*models.ScreenSchemeScheme, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p)
+	if err != nil || created == nil {
+		return nil, rs, err
+	}
+	// The create response only carries the new scheme's ID.
+	return r.getScheme(ctx, strconv.Itoa(created.ID))
+}
+
+// getScheme looks the screen scheme up by ID through the search endpoint; Jira has no single-scheme GET.
+func (r *screenSchemeResource) getScheme(ctx context.Context, id string) (*models.ScreenSchemeScheme, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid screen scheme id %q: %w", id, err)
+	}
+	page, rs, err := r.schemeService.Gets(ctx, &models.ScreenSchemeParamsScheme{IDs: []int{i}}, 0, 1)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, s := range page.Values {
+		if s != nil && s.ID == i {
+			return s, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("screen scheme %s not found", id)
+}
+
+// updateScheme sends the update itself because go-atlassian omits unset screen types, while Jira only clears a
+// screen type that is sent as null.
+func (r *screenSchemeResource) updateScheme(ctx context.Context, id string, p *models.ScreenSchemePayloadScheme) (*models.ScreenSchemeScheme, *models.ResponseScheme, error) {
+	screenID := func(v int) any {
+		if v == 0 {
+			return nil
+		}
+		return strconv.Itoa(v)
+	}
+	body := map[string]any{
+		"name":        p.Name,
+		"description": p.Description,
+		"screens": map[string]any{
+			"default": screenID(p.Screens.Default),
+			"create":  screenID(p.Screens.Create),
+			"edit":    screenID(p.Screens.Edit),
+			"view":    screenID(p.Screens.View),
+		},
+	}
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/screenscheme/%s", id), body, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *screenSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return r.schemeService.Delete(ctx, id)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *screenSchemeResource) hooks() CRUDHooks[screenSchemeResourceModel, *models.ScreenSchemePayloadScheme, *models.ScreenSchemeScheme] {
+	return CRUDHooks[screenSchemeResourceModel, *models.ScreenSchemePayloadScheme, *models.ScreenSchemeScheme]{
+		BuildPayload: func(ctx context.Context, st *screenSchemeResourceModel) (*models.ScreenSchemePayloadScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			// Validators guarantee numeric IDs; an unset screen type stays 0 and is omitted or cleared.
+			screenID := func(v string) int {
+				n, _ := strconv.Atoi(v)
+				return n
+			}
+			return &models.ScreenSchemePayloadScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+				Screens: &models.ScreenTypesScheme{
+					Default: screenID(st.DefaultScreenID.ValueString()),
+					Create:  screenID(st.CreateScreenID.ValueString()),
+					Edit:    screenID(st.EditScreenID.ValueString()),
+					View:    screenID(st.ViewScreenID.ValueString()),
+				},
+			}, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *screenSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapScreenSchemeSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *screenSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *screenSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *screenSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *screenSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *screenSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *screenSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccScreenSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_screen_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixScreenScheme)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetScreenSchemeCfg(t, testhelpers.ScreenSchemeTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("default_screen_id"), "jira_screen.default", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("create_screen_id"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetScreenSchemeCfg(t, testhelpers.ScreenSchemeTmplCfg{
+					Name:         name,
+					Description:  "Updated screen scheme description",
+					WorkTypeName: workTypeName,
+					SplitScreens: true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated screen scheme description")),
+					statecheck.CompareValuePairs(rName, tfjsonpath.New("create_screen_id"), "jira_screen.create", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("edit_screen_id"), knownvalue.Null()),
+				},
+			},
+			{
+				// Dropping the create screen must clear it in Jira, not leave it behind.
+				Config: testhelpers.GetScreenSchemeCfg(t, testhelpers.ScreenSchemeTmplCfg{Name: name, WorkTypeName: workTypeName}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("create_screen_id"), knownvalue.Null()),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
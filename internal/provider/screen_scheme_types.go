@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// screenSchemeResourceModel models the Terraform schema/state for jira_screen_scheme.
+type screenSchemeResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	DefaultScreenID types.String `tfsdk:"default_screen_id"`
+	CreateScreenID  types.String `tfsdk:"create_screen_id"`
+	EditScreenID    types.String `tfsdk:"edit_screen_id"`
+	ViewScreenID    types.String `tfsdk:"view_screen_id"`
+}
+
+func (m *screenSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"name":              types.StringType,
+		"description":       types.StringType,
+		"default_screen_id": types.StringType,
+		"create_screen_id":  types.StringType,
+		"edit_screen_id":    types.StringType,
+		"view_screen_id":    types.StringType,
+	}
+}
+
+// screenIDOrNull maps a screen ID from a screen scheme to state; Jira reports an unset screen type as 0.
+func screenIDOrNull(id int) types.String {
+	if id == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.Itoa(id))
+}
+
+// mapScreenSchemeSchemeToModel centralizes mapping for the screen scheme resource and matches CRUDHooks MapToState signature.
+func mapScreenSchemeSchemeToModel(_ context.Context, api *models.ScreenSchemeScheme, st *screenSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no screen scheme payload to map into state.")
+		return diags
+	}
+	screens := api.Screens
+	if screens == nil {
+		screens = &models.ScreenTypesScheme{}
+	}
+	*st = screenSchemeResourceModel{
+		ID:              types.StringValue(strconv.Itoa(api.ID)),
+		Name:            types.StringValue(api.Name),
+		Description:     stringOrNull(api.Description),
+		DefaultScreenID: screenIDOrNull(screens.Default),
+		CreateScreenID:  screenIDOrNull(screens.Create),
+		EditScreenID:    screenIDOrNull(screens.Edit),
+		ViewScreenID:    screenIDOrNull(screens.View),
+	}
+	return diags
+}
+
+// issueTypeScreenSchemeResourceModel models the Terraform schema/state for jira_issue_type_screen_scheme.
+type issueTypeScreenSchemeResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	DefaultScreenSchemeID types.String `tfsdk:"default_screen_scheme_id"`
+	WorkTypeScreenSchemes types.Map    `tfsdk:"work_type_screen_schemes"`
+	ProjectIDs            types.Set    `tfsdk:"project_ids"`
+}
+
+func (m *issueTypeScreenSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                       types.StringType,
+		"name":                     types.StringType,
+		"description":              types.StringType,
+		"default_screen_scheme_id": types.StringType,
+		"work_type_screen_schemes": types.MapType{ElemType: types.StringType},
+		"project_ids":              types.SetType{ElemType: types.StringType},
+	}
+}
+
+// issueTypeScreenSchemeAPIModel wraps the go-atlassian issue type screen scheme with its mappings and the managed
+// projects using it; Jira serves both from separate endpoints. DefaultScreenSchemeID holds the mapping for the
+// "default" pseudo work type, WorkTypeScreenSchemes the rest. ProjectIDs stays nil when project assignment is
+// not managed.
+type issueTypeScreenSchemeAPIModel struct {
+	*models.IssueTypeScreenSchemeScheme
+	DefaultScreenSchemeID string
+	WorkTypeScreenSchemes map[string]string
+	ProjectIDs            []string
+}
+
+// mapIssueTypeScreenSchemeToModel centralizes mapping for the issue type screen scheme resource and matches CRUDHooks MapToState signature.
+func mapIssueTypeScreenSchemeToModel(ctx context.Context, api *issueTypeScreenSchemeAPIModel, st *issueTypeScreenSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.IssueTypeScreenSchemeScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no issue type screen scheme payload to map into state.")
+		return diags
+	}
+
+	mappings := types.MapNull(types.StringType)
+	if len(api.WorkTypeScreenSchemes) > 0 {
+		var d diag.Diagnostics
+		mappings, d = types.MapValueFrom(ctx, types.StringType, api.WorkTypeScreenSchemes)
+		diags.Append(d...)
+	}
+
+	projectIDs := types.SetNull(types.StringType)
+	if api.ProjectIDs != nil {
+		var d diag.Diagnostics
+		projectIDs, d = types.SetValueFrom(ctx, types.StringType, api.ProjectIDs)
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = issueTypeScreenSchemeResourceModel{
+		ID:                    types.StringValue(api.ID),
+		Name:                  types.StringValue(api.Name),
+		Description:           stringOrNull(api.Description),
+		DefaultScreenSchemeID: stringOrNull(api.DefaultScreenSchemeID),
+		WorkTypeScreenSchemes: mappings,
+		ProjectIDs:            projectIDs,
+	}
+	return diags
+}
@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*screenTabResource)(nil)
+var _ resource.ResourceWithConfigure = (*screenTabResource)(nil)
+var _ resource.ResourceWithImportState = (*screenTabResource)(nil)
+
+// screenTabFieldPositionFirst is the move endpoint position that places a field first on its tab.
+const screenTabFieldPositionFirst = "First"
+
+// NewScreenTabResource returns the Terraform resource implementation for jira_screen_tab.
+func NewScreenTabResource() resource.Resource { return &screenTabResource{} }
+
+type screenTabResource struct {
+	ServiceClient
+	tabService      jira.ScreenTabConnector
+	tabFieldService jira.ScreenTabFieldConnector
+	crudRunner      CRUDRunner[screenTabResourceModel, *screenTabPayload, *screenTabAPIModel]
+}
+
+func (r *screenTabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_screen_tab"
+}
+
+func (r *screenTabResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.tabService = provider.client.Screen.Tab
+	r.tabFieldService = provider.client.Screen.Tab.Field
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *screenTabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a tab on a Jira screen and the ordered fields placed on it. Tabs and fields are reordered with Jira's move operations rather than being removed and re-added.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the tab. Automatically generated by Jira when the tab is created.",
+			},
+			"screen_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "ID of the screen the tab belongs to (for example `jira_screen.example.id`). Changing this forces a new tab.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric screen ID")},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the tab. Must be unique within the screen.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"position": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Zero-based position of the tab among the screen's tabs. When omitted, the tab stays where Jira put it (new tabs are added last).",
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"field_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Ordered list of field IDs placed on the tab, for example `summary` or `jira_field.example.id`. When omitted, the fields on the tab are not managed.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *screenTabResource) createTab(ctx context.Context, p *screenTabPayload) (*screenTabAPIModel, *models.ResponseScheme, error) {
+	tab, rs, err := r.tabService.Create(ctx, p.ScreenID, p.Name)
+	if err != nil || tab == nil {
+		return nil, rs, err
+	}
+	key := fmt.Sprintf("%d/%d", p.ScreenID, tab.ID)
+	current, rs, err := r.getTab(ctx, key)
+	if err != nil {
+		return nil, rs, err
+	}
+	if rs, err := r.applyLayout(ctx, current, p); err != nil {
+		return nil, rs, err
+	}
+	return r.getTab(ctx, key)
+}
+
+// getTab reads the tab by its "screen_id/tab_id" key, including its position and fields in display order.
+func (r *screenTabResource) getTab(ctx context.Context, key string) (*screenTabAPIModel, *models.ResponseScheme, error) {
+	screenID, tabID, err := parseScreenTabKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	tabs, rs, err := r.tabService.Gets(ctx, screenID, "")
+	if err != nil {
+		return nil, rs, err
+	}
+	var api *screenTabAPIModel
+	for i, t := range tabs {
+		if t != nil && t.ID == tabID {
+			api = &screenTabAPIModel{ScreenTabScheme: t, ScreenID: screenID, Position: i}
+		}
+	}
+	if api == nil {
+		return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("screen tab %s not found", key)
+	}
+
+	fields, fieldsRS, err := r.tabFieldService.Gets(ctx, screenID, tabID)
+	if err != nil {
+		return nil, fieldsRS, err
+	}
+	api.FieldIDs = make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f != nil {
+			api.FieldIDs = append(api.FieldIDs, f.ID)
+		}
+	}
+	return api, rs, nil
+}
+
+func (r *screenTabResource) updateTab(ctx context.Context, key string, p *screenTabPayload) (*screenTabAPIModel, *models.ResponseScheme, error) {
+	current, rs, err := r.getTab(ctx, key)
+	if err != nil {
+		return nil, rs, err
+	}
+	// Jira validates tab names as unique within the screen, so only send real renames.
+	if current.Name != p.Name {
+		if _, rs, err := r.tabService.Update(ctx, current.ScreenID, current.ID, p.Name); err != nil {
+			return nil, rs, err
+		}
+	}
+	if rs, err := r.applyLayout(ctx, current, p); err != nil {
+		return nil, rs, err
+	}
+	return r.getTab(ctx, key)
+}
+
+// applyLayout moves the tab to its planned position and reconciles the fields on it: missing fields are added,
+// extra fields removed and the rest reordered in place with move operations.
+func (r *screenTabResource) applyLayout(ctx context.Context, current *screenTabAPIModel, p *screenTabPayload) (*models.ResponseScheme, error) {
+	screenID, tabID := current.ScreenID, current.ID
+	if p.Position != nil && *p.Position != current.Position {
+		if rs, err := r.tabService.Move(ctx, screenID, tabID, *p.Position); err != nil {
+			return rs, err
+		}
+	}
+	if p.FieldIDs == nil {
+		return nil, nil
+	}
+
+	order := make([]string, 0, len(p.FieldIDs))
+	for _, f := range current.FieldIDs {
+		if slices.Contains(p.FieldIDs, f) {
+			order = append(order, f)
+			continue
+		}
+		if rs, err := r.tabFieldService.Remove(ctx, screenID, tabID, f); err != nil {
+			return rs, err
+		}
+	}
+	for _, f := range p.FieldIDs {
+		if slices.Contains(order, f) {
+			continue
+		}
+		// Jira appends added fields to the end of the tab.
+		if _, rs, err := r.tabFieldService.Add(ctx, screenID, tabID, f); err != nil {
+			return rs, err
+		}
+		order = append(order, f)
+	}
+
+	for i, f := range p.FieldIDs {
+		if order[i] == f {
+			continue
+		}
+		after := ""
+		if i > 0 {
+			after = p.FieldIDs[i-1]
+		}
+		if rs, err := r.moveField(ctx, screenID, tabID, f, after); err != nil {
+			return rs, err
+		}
+		order = slices.Insert(slices.DeleteFunc(order, func(s string) bool { return s == f }), i, f)
+	}
+	return nil, nil
+}
+
+// moveField places a field first on the tab, or directly after another field. go-atlassian always sends both
+// "after" and "position", and Jira rejects an empty "after", so the request is built here.
+func (r *screenTabResource) moveField(ctx context.Context, screenID, tabID int, fieldID, after string) (*models.ResponseScheme, error) {
+	body := map[string]string{"position": screenTabFieldPositionFirst}
+	if after != "" {
+		body = map[string]string{"after": after}
+	}
+	endpoint := fmt.Sprintf("rest/api/3/screens/%d/tabs/%d/fields/%s/move", screenID, tabID, fieldID)
+	return callJira(ctx, r.client, http.MethodPost, endpoint, body, nil)
+}
+
+// deleteTab removes the tab. Jira requires every screen to keep one tab, so the last tab is left for the screen's
+// own deletion to clean up instead of failing the destroy.
+func (r *screenTabResource) deleteTab(ctx context.Context, key string) (*models.ResponseScheme, error) {
+	screenID, tabID, err := parseScreenTabKey(key)
+	if err != nil {
+		return nil, err
+	}
+	tabs, rs, err := r.tabService.Gets(ctx, screenID, "")
+	if err != nil {
+		return rs, err
+	}
+	if len(tabs) == 1 && tabs[0] != nil && tabs[0].ID == tabID {
+		tflog.Warn(ctx, "not deleting the last tab of a screen; it is removed together with the screen", map[string]interface{}{"screen_id": screenID, "tab_id": tabID})
+		return &models.ResponseScheme{Code: http.StatusNoContent}, nil
+	}
+	return r.tabService.Delete(ctx, screenID, tabID)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *screenTabResource) hooks() CRUDHooks[screenTabResourceModel, *screenTabPayload, *screenTabAPIModel] {
+	return CRUDHooks[screenTabResourceModel, *screenTabPayload, *screenTabAPIModel]{
+		BuildPayload: func(ctx context.Context, st *screenTabResourceModel) (*screenTabPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			screenID, err := strconv.Atoi(st.ScreenID.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("screen_id"), "Invalid screen ID", fmt.Sprintf("%q is not a numeric screen ID.", st.ScreenID.ValueString()))
+				return nil, diags
+			}
+			p := &screenTabPayload{
+				ScreenID: screenID,
+				Name:     st.Name.ValueString(),
+			}
+			if !st.Position.IsNull() && !st.Position.IsUnknown() {
+				pos := int(st.Position.ValueInt64())
+				p.Position = &pos
+			}
+			if !st.FieldIDs.IsNull() && !st.FieldIDs.IsUnknown() {
+				p.FieldIDs = []string{}
+				diags.Append(st.FieldIDs.ElementsAs(ctx, &p.FieldIDs, false)...)
+			}
+			return p, diags
+		},
+		APICreate:               r.createTab,
+		APIRead:                 r.getTab,
+		APIUpdate:               r.updateTab,
+		APIDelete:               r.deleteTab,
+		ExtractID:               func(st *screenTabResourceModel) string { return st.screenTabKey() },
+		MapToState:              mapScreenTabToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *screenTabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *screenTabResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenTabResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenTabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *screenTabResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenTabResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenTabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *screenTabResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *screenTabResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *screenTabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *screenTabResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState accepts "screen_id/tab_id", the same key the tab endpoints are addressed by.
+func (r *screenTabResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *screenTabResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
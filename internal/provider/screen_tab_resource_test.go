@@ -0,0 +1,74 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccScreenTabResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_screen_tab.test"
+	name := acctest.RandomWithPrefix(accPrefixScreen)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetScreenCfg(t, testhelpers.ScreenTmplCfg{Name: name, TabName: "Details"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact("Details")),
+					// Jira creates a default tab with every screen, so the new tab comes second.
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("position"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("field_ids"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("summary"),
+						knownvalue.StringExact("description"),
+						knownvalue.StringExact("duedate"),
+					})),
+				},
+			},
+			{
+				Config: testhelpers.GetScreenCfg(t, testhelpers.ScreenTmplCfg{Name: name, TabName: "Overview", Reverse: true}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact("Overview")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("position"), knownvalue.Int64Exact(0)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("field_ids"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("duedate"),
+						knownvalue.StringExact("description"),
+						knownvalue.StringExact("summary"),
+					})),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      rName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rName]
+					if !ok {
+						return "", fmt.Errorf("resource %s not found in state", rName)
+					}
+					return rs.Primary.Attributes["screen_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
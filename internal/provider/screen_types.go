@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// screenResourceModel models the Terraform schema/state for jira_screen.
+type screenResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *screenResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+	}
+}
+
+// mapScreenSchemeToModel centralizes mapping for the screen resource and matches CRUDHooks MapToState signature.
+func mapScreenSchemeToModel(_ context.Context, api *models.ScreenScheme, st *screenResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no screen payload to map into state.")
+		return diags
+	}
+	*st = screenResourceModel{
+		ID:          types.StringValue(strconv.Itoa(api.ID)),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+	}
+	return diags
+}
+
+// screenTabResourceModel models the Terraform schema/state for jira_screen_tab.
+type screenTabResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ScreenID types.String `tfsdk:"screen_id"`
+	Name     types.String `tfsdk:"name"`
+	Position types.Int64  `tfsdk:"position"`
+	FieldIDs types.List   `tfsdk:"field_ids"`
+}
+
+func (m *screenTabResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":        types.StringType,
+		"screen_id": types.StringType,
+		"name":      types.StringType,
+		"position":  types.Int64Type,
+		"field_ids": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// screenTabKey returns the "screen_id/tab_id" key the tab endpoints are addressed by; it is also the import ID.
+func (m *screenTabResourceModel) screenTabKey() string {
+	return m.ScreenID.ValueString() + "/" + m.ID.ValueString()
+}
+
+// parseScreenTabKey splits a "screen_id/tab_id" key into its numeric parts.
+func parseScreenTabKey(key string) (screenID, tabID int, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid screen tab id %q: expected format screen_id/tab_id", key)
+	}
+	if screenID, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, fmt.Errorf("invalid screen id %q: %w", parts[0], err)
+	}
+	if tabID, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, fmt.Errorf("invalid screen tab id %q: %w", parts[1], err)
+	}
+	return screenID, tabID, nil
+}
+
+// screenTabPayload carries the planned tab for create/update. go-atlassian addresses tabs through positional
+// arguments rather than a payload type, so the provider defines its own. Position is nil when not configured
+// and FieldIDs is nil when field placement is not configured.
+type screenTabPayload struct {
+	ScreenID int
+	Name     string
+	Position *int
+	FieldIDs []string
+}
+
+// screenTabAPIModel wraps the go-atlassian tab model with the screen it belongs to, its zero-based position
+// among the screen's tabs and its fields in display order.
+type screenTabAPIModel struct {
+	*models.ScreenTabScheme
+	ScreenID int
+	Position int
+	FieldIDs []string
+}
+
+// mapScreenTabToModel centralizes mapping for the screen tab resource and matches CRUDHooks MapToState signature.
+func mapScreenTabToModel(ctx context.Context, api *screenTabAPIModel, st *screenTabResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.ScreenTabScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no screen tab payload to map into state.")
+		return diags
+	}
+	fieldIDs, d := types.ListValueFrom(ctx, types.StringType, api.FieldIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	*st = screenTabResourceModel{
+		ID:       types.StringValue(strconv.Itoa(api.ID)),
+		ScreenID: types.StringValue(strconv.Itoa(api.ScreenID)),
+		Name:     types.StringValue(api.Name),
+		Position: types.Int64Value(int64(api.Position)),
+		FieldIDs: fieldIDs,
+	}
+	return diags
+}
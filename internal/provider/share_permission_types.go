@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Types of the share and edit permissions of filters and dashboards, as configured in Terraform.
+const (
+	sharePermissionTypeGroup       = "group"
+	sharePermissionTypeProject     = "project"
+	sharePermissionTypeProjectRole = "project_role"
+)
+
+// sharePermissionModel models a single element of the share_permissions and edit_permissions sets.
+type sharePermissionModel struct {
+	Type      types.String `tfsdk:"type"`
+	GroupID   types.String `tfsdk:"group_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	RoleID    types.String `tfsdk:"role_id"`
+}
+
+func (m *sharePermissionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":       types.StringType,
+		"group_id":   types.StringType,
+		"project_id": types.StringType,
+		"role_id":    types.StringType,
+	}
+}
+
+// sharePermissionAPIModel is a share or edit permission of a filter or dashboard. Jira reports a project role permission either
+// as type projectRole or as type project with a role.
+type sharePermissionAPIModel struct {
+	Type    string                  `json:"type"`
+	Group   *sharePermissionGroup   `json:"group,omitempty"`
+	Project *sharePermissionProject `json:"project,omitempty"`
+	Role    *sharePermissionRole    `json:"role,omitempty"`
+}
+
+// sharePermissionGroup identifies the group of a group permission.
+type sharePermissionGroup struct {
+	GroupID string `json:"groupId"`
+}
+
+// sharePermissionProject identifies the project of a project or project role permission.
+type sharePermissionProject struct {
+	ID string `json:"id"`
+}
+
+// sharePermissionRole identifies the role of a project role permission.
+type sharePermissionRole struct {
+	ID int `json:"id"`
+}
+
+// newSharePermissionAPIModel converts a configured permission into its Jira form.
+func newSharePermissionAPIModel(m sharePermissionModel) *sharePermissionAPIModel {
+	switch m.Type.ValueString() {
+	case sharePermissionTypeGroup:
+		return &sharePermissionAPIModel{Type: "group", Group: &sharePermissionGroup{GroupID: m.GroupID.ValueString()}}
+	case sharePermissionTypeProjectRole:
+		roleID, _ := strconv.Atoi(m.RoleID.ValueString())
+		return &sharePermissionAPIModel{
+			Type:    "projectRole",
+			Project: &sharePermissionProject{ID: m.ProjectID.ValueString()},
+			Role:    &sharePermissionRole{ID: roleID},
+		}
+	default:
+		return &sharePermissionAPIModel{Type: "project", Project: &sharePermissionProject{ID: m.ProjectID.ValueString()}}
+	}
+}
+
+// sharePermissionModelFromAPI converts a Jira permission into its Terraform form. Permissions of other types, such as
+// ones shared with everyone or a single user, are not managed and are skipped.
+func sharePermissionModelFromAPI(p *sharePermissionAPIModel) (sharePermissionModel, bool) {
+	m := sharePermissionModel{GroupID: types.StringNull(), ProjectID: types.StringNull(), RoleID: types.StringNull()}
+	switch {
+	case p.Type == "group" && p.Group != nil:
+		m.Type = types.StringValue(sharePermissionTypeGroup)
+		m.GroupID = types.StringValue(p.Group.GroupID)
+	case (p.Type == "projectRole" || p.Type == "project") && p.Project != nil && p.Role != nil:
+		m.Type = types.StringValue(sharePermissionTypeProjectRole)
+		m.ProjectID = types.StringValue(p.Project.ID)
+		m.RoleID = types.StringValue(strconv.Itoa(p.Role.ID))
+	case p.Type == "project" && p.Project != nil:
+		m.Type = types.StringValue(sharePermissionTypeProject)
+		m.ProjectID = types.StringValue(p.Project.ID)
+	default:
+		return m, false
+	}
+	return m, true
+}
+
+// sharePermissionSet maps Jira permissions to a set, null when there are none.
+func sharePermissionSet(ctx context.Context, permissions []*sharePermissionAPIModel) (types.Set, diag.Diagnostics) {
+	permissionType := types.ObjectType{AttrTypes: (&sharePermissionModel{}).AttributeTypes()}
+	var out []sharePermissionModel
+	for _, p := range permissions {
+		if p == nil {
+			continue
+		}
+		if m, ok := sharePermissionModelFromAPI(p); ok {
+			out = append(out, m)
+		}
+	}
+	if len(out) == 0 {
+		return types.SetNull(permissionType), nil
+	}
+	return types.SetValueFrom(ctx, permissionType, out)
+}
+
+// sharePermissionsAttribute returns the schema of the share_permissions and edit_permissions sets.
+func sharePermissionsAttribute(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Who the permission is given to: `group`, `project` (everyone who can browse the project) or `project_role` (members of a role in the project).",
+					Validators: []validator.String{stringvalidator.OneOf(
+						sharePermissionTypeGroup,
+						sharePermissionTypeProject,
+						sharePermissionTypeProjectRole,
+					)},
+				},
+				"group_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "ID of the group. Required for `group` and must be omitted otherwise.",
+					Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+				"project_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "ID of the project. Required for `project` and `project_role` and must be omitted for `group`.",
+					Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project ID")},
+				},
+				"role_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "ID of the project role. Required for `project_role` and must be omitted otherwise.",
+					Validators:          []validator.String{stringvalidator.RegexMatches(numericIDRegex, "must be a numeric project role ID")},
+				},
+			},
+		},
+	}
+}
+
+// validateSharePermissions checks that every permission in set sets exactly the IDs its type needs.
+func validateSharePermissions(ctx context.Context, set types.Set, setPath path.Path, diags *diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return
+	}
+	var permissions []sharePermissionModel
+	diags.Append(set.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return
+	}
+	for _, p := range permissions {
+		if p.Type.IsUnknown() {
+			continue
+		}
+		permissionType := p.Type.ValueString()
+		wants := map[string]bool{
+			"group_id":   permissionType == sharePermissionTypeGroup,
+			"project_id": permissionType == sharePermissionTypeProject || permissionType == sharePermissionTypeProjectRole,
+			"role_id":    permissionType == sharePermissionTypeProjectRole,
+		}
+		values := map[string]types.String{"group_id": p.GroupID, "project_id": p.ProjectID, "role_id": p.RoleID}
+		for _, name := range []string{"group_id", "project_id", "role_id"} {
+			v := values[name]
+			switch {
+			case v.IsUnknown():
+				continue
+			case wants[name] && v.IsNull():
+				diags.AddAttributeError(setPath, "Missing permission attribute", fmt.Sprintf("A %q permission requires %s.", permissionType, name))
+			case !wants[name] && !v.IsNull():
+				diags.AddAttributeError(setPath, "Unexpected permission attribute", fmt.Sprintf("A %q permission does not take %s.", permissionType, name))
+			}
+		}
+	}
+}
+
+// sharePermissionsFromSet converts a configured permission set into its Jira form.
+func sharePermissionsFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []*sharePermissionAPIModel {
+	out := []*sharePermissionAPIModel{}
+	if set.IsNull() || set.IsUnknown() {
+		return out
+	}
+	var permissions []sharePermissionModel
+	diags.Append(set.ElementsAs(ctx, &permissions, false)...)
+	for _, p := range permissions {
+		out = append(out, newSharePermissionAPIModel(p))
+	}
+	return out
+}
@@ -5,9 +5,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
+	jira "github.com/ctreminiom/go-atlassian/v2/jira/v3"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/constants"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,6 +38,14 @@ func int64OrNull(v int64) types.Int64 {
 
 func boolValue(b bool) types.Bool { return types.BoolValue(b) }
 
+// stringSetOrNull returns a set of the given strings, or a null set when there are none.
+func stringSetOrNull(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
 // ensureWith wraps EnsureSuccessOrDiagFromSchemeWithOptions binding the diagnostics pointer.
 // Use in Resource CRUD/Import methods to avoid repeating the closure at each callsite.
 func ensureWith(diags *diag.Diagnostics) func(ctx context.Context, action string, resp *models.ResponseScheme, err error, opts *EnsureSuccessOrDiagOptions) bool {
@@ -109,3 +120,89 @@ func uniqueStrings(in []string) []string {
 	}
 	return out
 }
+
+// Terminal states reported by the Jira /task endpoint.
+var jiraTaskTerminalStatuses = map[string]struct{}{
+	"COMPLETE":  {},
+	"FAILED":    {},
+	"CANCELLED": {},
+	"DEAD":      {},
+}
+
+// jiraTaskPollInterval is the delay between /task polls while waiting for an async operation.
+const jiraTaskPollInterval = 2 * time.Second
+
+// waitForJiraTask polls an asynchronous Jira task until it reaches a terminal state or ctx expires.
+// A task that finishes in any state other than COMPLETE is returned as an error carrying its status,
+// progress and result so callers can surface it in diagnostics.
+func waitForJiraTask(ctx context.Context, client *jira.Client, taskID, action string) (*models.TaskScheme, *models.ResponseScheme, error) {
+	for {
+		task, rs, err := client.Task.Get(ctx, taskID)
+		if err != nil {
+			return nil, rs, err
+		}
+		if _, done := jiraTaskTerminalStatuses[task.Status]; done {
+			if task.Status != "COMPLETE" {
+				return task, rs, fmt.Errorf("%s: task %s finished with status %s (progress %d%%): %s", action, taskID, task.Status, task.Progress, task.Result)
+			}
+			return task, rs, nil
+		}
+		tflog.Debug(ctx, "waiting for Jira task", map[string]interface{}{"task_id": taskID, "status": task.Status, "progress": task.Progress})
+		t := time.NewTimer(jiraTaskPollInterval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return task, rs, fmt.Errorf("%s: gave up waiting for task %s in status %s (progress %d%%): %w", action, taskID, task.Status, task.Progress, ctx.Err())
+		case <-t.C:
+		}
+	}
+}
+
+// lookupFieldType returns the short field type key (see constants.FieldTypesMap) of a custom field.
+func lookupFieldType(ctx context.Context, client *jira.Client, fieldID string) (string, *models.ResponseScheme, error) {
+	page, rs, err := client.Issue.Field.Search(ctx, &models.FieldSearchOptionsScheme{IDs: []string{fieldID}}, 0, 1)
+	if err != nil {
+		return "", rs, err
+	}
+	for _, f := range page.Values {
+		if f != nil && f.ID == fieldID && f.Schema != nil {
+			return constants.GetFieldTypeShort(f.Schema.Custom), rs, nil
+		}
+	}
+	return "", &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("field %s not found", fieldID)
+}
+
+// putFieldContextDefault sets the default value of a custom field context. The request is sent directly because
+// go-atlassian omits unset properties, while Jira only clears a default whose value property is sent as null.
+func putFieldContextDefault(ctx context.Context, client *jira.Client, fieldID string, defaultValue map[string]any) (*models.ResponseScheme, error) {
+	body := map[string]any{"defaultValues": []any{defaultValue}}
+	return callJira(ctx, client, http.MethodPut, fmt.Sprintf("rest/api/3/field/%s/context/defaultValue", fieldID), body, nil)
+}
+
+// checkAccountAtPlan adds an attribute error when Jira reports that accountID does not exist or is inactive, so a
+// mistyped account fails at plan time rather than during apply. The check is best effort: when Jira cannot be
+// reached, for example because the provider is not configured yet, it is skipped and the apply reports any problem.
+func checkAccountAtPlan(ctx context.Context, client *jira.Client, attrPath path.Path, accountID string, diags *diag.Diagnostics) {
+	if client == nil || accountID == "" {
+		return
+	}
+	user, rs, err := client.User.Get(ctx, accountID, nil)
+	switch {
+	case HTTPStatusFromScheme(rs) == http.StatusNotFound:
+		diags.AddAttributeError(attrPath, "Unknown account", fmt.Sprintf("Jira has no user with account ID %q, or it is not visible to the provider's credentials.", accountID))
+	case err != nil:
+		tflog.Debug(ctx, "skipping account check at plan time", map[string]interface{}{"status": HTTPStatusFromScheme(rs)})
+	case user != nil && !user.Active:
+		diags.AddAttributeError(attrPath, "Inactive account", fmt.Sprintf("The Jira user with account ID %q is inactive.", accountID))
+	}
+}
+
+// callJira sends a request to a Jira REST endpoint that go-atlassian does not wrap and decodes the response into
+// out, which may be nil when the response has no body of interest.
+func callJira(ctx context.Context, client *jira.Client, method, endpoint string, body, out any) (*models.ResponseScheme, error) {
+	req, err := client.NewRequest(ctx, method, endpoint, "", body)
+	if err != nil {
+		return nil, err
+	}
+	return client.Call(req, out)
+}
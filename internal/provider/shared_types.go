@@ -3,6 +3,8 @@
 package provider
 
 import (
+	"regexp"
+
 	jira "github.com/ctreminiom/go-atlassian/v2/jira/v3"
 )
 
@@ -10,3 +12,13 @@ type ServiceClient struct {
 	client           *jira.Client
 	providerTimeouts opTimeouts
 }
+
+// numericIDRegex matches the numeric string IDs Jira uses for most entities (work types, schemes, projects).
+var numericIDRegex = regexp.MustCompile(`^[0-9]+$`)
+
+// Membership modes of the resources that manage the members of a group or role (jira_project_role_actors,
+// jira_group_membership). Additive manages only the configured members; authoritative removes every other member.
+const (
+	membershipModeAdditive      = "additive"
+	membershipModeAuthoritative = "authoritative"
+)
@@ -53,8 +53,30 @@ func TestMain(m *testing.M) {
 }
 
 const (
-	accPrefixWorkType       = "tf-acc-work-type"
-	accPrefixWorkflowStatus = "tf-acc-workflow-status"
+	accPrefixWorkType        = "tf-acc-work-type"
+	accPrefixWorkflowStatus  = "tf-acc-workflow-status"
+	accPrefixWorkflow        = "tf-acc-workflow"
+	accPrefixWorkflowScheme  = "tf-acc-workflow-scheme"
+	accPrefixIssueTypeScheme = "tf-acc-issue-type-scheme"
+	accPrefixScreen          = "tf-acc-screen"
+	accPrefixScreenScheme    = "tf-acc-screen-scheme"
+	accPrefixFieldContext    = "tf-acc-field-context"
+	accPrefixFieldConfig     = "tf-acc-field-config"
+	accPrefixPermission      = "tf-acc-permission"
+	accPrefixNotification    = "tf-acc-notification"
+	accPrefixIssueSecurity   = "tf-acc-issue-security"
+	accPrefixProjectRole     = "tf-acc-project-role"
+	accPrefixGroup           = "tf-acc-group"
+	accPrefixPriority        = "tf-acc-priority"
+	accPrefixResolution      = "tf-acc-resolution"
+	accPrefixIssueLinkType   = "tf-acc-issue-link-type"
+	accPrefixComponent       = "tf-acc-component"
+	accPrefixVersion         = "tf-acc-version"
+	accPrefixFilter          = "tf-acc-filter"
+	accPrefixDashboard       = "tf-acc-dashboard"
+	accPrefixBoard           = "tf-acc-board"
+	accPrefixWebhook         = "tf-acc-webhook"
+	accPrefixBanner          = "tf-acc-banner"
 )
 
 // retry tuning for sweeper (kept conservative)
@@ -21,6 +21,56 @@ const (
 	ProjectCatTmpl = "project_category.tf.tmpl"
 	// FieldTmpl is the filename for the field Terraform template.
 	FieldTmpl = "field.tf.tmpl"
+	// WorkflowStatusTmpl is the filename for the workflow_status Terraform template.
+	WorkflowStatusTmpl = "workflow_status.tf.tmpl"
+	// DataWorkflowStatusesTmpl is the filename for the data.workflow_statuses Terraform template.
+	DataWorkflowStatusesTmpl = "data.workflow_statuses.tf.tmpl"
+	// WorkflowTmpl is the filename for the workflow Terraform template.
+	WorkflowTmpl = "workflow.tf.tmpl"
+	// WorkflowSchemeTmpl is the filename for the workflow_scheme Terraform template.
+	WorkflowSchemeTmpl = "workflow_scheme.tf.tmpl"
+	// IssueTypeSchemeTmpl is the filename for the issue_type_scheme Terraform template.
+	IssueTypeSchemeTmpl = "issue_type_scheme.tf.tmpl"
+	// ScreenTmpl is the filename for the screen Terraform template.
+	ScreenTmpl = "screen.tf.tmpl"
+	// ScreenSchemeTmpl is the filename for the screen_scheme Terraform template.
+	ScreenSchemeTmpl = "screen_scheme.tf.tmpl"
+	// FieldContextTmpl is the filename for the field_context Terraform template.
+	FieldContextTmpl = "field_context.tf.tmpl"
+	// FieldConfigurationTmpl is the filename for the field_configuration Terraform template.
+	FieldConfigurationTmpl = "field_configuration.tf.tmpl"
+	// PermissionSchemeTmpl is the filename for the permission_scheme Terraform template.
+	PermissionSchemeTmpl = "permission_scheme.tf.tmpl"
+	// NotificationSchemeTmpl is the filename for the notification_scheme Terraform template.
+	NotificationSchemeTmpl = "notification_scheme.tf.tmpl"
+	// IssueSecuritySchemeTmpl is the filename for the issue_security_scheme Terraform template.
+	IssueSecuritySchemeTmpl = "issue_security_scheme.tf.tmpl"
+	// ProjectRoleTmpl is the filename for the project_role Terraform template.
+	ProjectRoleTmpl = "project_role.tf.tmpl"
+	// GroupTmpl is the filename for the group Terraform template.
+	GroupTmpl = "group.tf.tmpl"
+	// PriorityTmpl is the filename for the priority Terraform template.
+	PriorityTmpl = "priority.tf.tmpl"
+	// ResolutionTmpl is the filename for the resolution Terraform template.
+	ResolutionTmpl = "resolution.tf.tmpl"
+	// IssueLinkTypeTmpl is the filename for the issue_link_type Terraform template.
+	IssueLinkTypeTmpl = "issue_link_type.tf.tmpl"
+	// ProjectComponentTmpl is the filename for the project_component Terraform template.
+	ProjectComponentTmpl = "project_component.tf.tmpl"
+	// ProjectVersionTmpl is the filename for the project_version Terraform template.
+	ProjectVersionTmpl = "project_version.tf.tmpl"
+	// FilterTmpl is the filename for the filter Terraform template.
+	FilterTmpl = "filter.tf.tmpl"
+	// DashboardTmpl is the filename for the dashboard Terraform template.
+	DashboardTmpl = "dashboard.tf.tmpl"
+	// BoardTmpl is the filename for the board Terraform template.
+	BoardTmpl = "board.tf.tmpl"
+	// WebhookTmpl is the filename for the webhook Terraform template.
+	WebhookTmpl = "webhook.tf.tmpl"
+	// AnnouncementBannerTmpl is the filename for the announcement_banner Terraform template.
+	AnnouncementBannerTmpl = "announcement_banner.tf.tmpl"
+	// ApplicationPropertyTmpl is the filename for the application_property Terraform template.
+	ApplicationPropertyTmpl = "application_property.tf.tmpl"
 )
 
 // TemplatesDir defines the base directory for template files.
@@ -36,12 +86,37 @@ func tmplPath(name string) string {
 }
 
 var (
-	DataWorkTypesTmplPath = tmplPath(DataWorkTypesTmpl)
-	WorkTypeTmplPath      = tmplPath(WorkTypeTmpl)
-	DataProjectTmplPath   = tmplPath(DataProjectTmpl)
-	ProjectTmplPath       = tmplPath(ProjectTmpl)
-	ProjectCatTmplPath    = tmplPath(ProjectCatTmpl)
-	FieldTmplPath         = tmplPath(FieldTmpl)
+	DataWorkTypesTmplPath        = tmplPath(DataWorkTypesTmpl)
+	WorkTypeTmplPath             = tmplPath(WorkTypeTmpl)
+	DataProjectTmplPath          = tmplPath(DataProjectTmpl)
+	ProjectTmplPath              = tmplPath(ProjectTmpl)
+	ProjectCatTmplPath           = tmplPath(ProjectCatTmpl)
+	FieldTmplPath                = tmplPath(FieldTmpl)
+	WorkflowStatusTmplPath       = tmplPath(WorkflowStatusTmpl)
+	DataWorkflowStatusesTmplPath = tmplPath(DataWorkflowStatusesTmpl)
+	WorkflowTmplPath             = tmplPath(WorkflowTmpl)
+	WorkflowSchemeTmplPath       = tmplPath(WorkflowSchemeTmpl)
+	IssueTypeSchemeTmplPath      = tmplPath(IssueTypeSchemeTmpl)
+	ScreenTmplPath               = tmplPath(ScreenTmpl)
+	ScreenSchemeTmplPath         = tmplPath(ScreenSchemeTmpl)
+	FieldContextTmplPath         = tmplPath(FieldContextTmpl)
+	FieldConfigurationTmplPath   = tmplPath(FieldConfigurationTmpl)
+	PermissionSchemeTmplPath     = tmplPath(PermissionSchemeTmpl)
+	NotificationSchemeTmplPath   = tmplPath(NotificationSchemeTmpl)
+	IssueSecuritySchemeTmplPath  = tmplPath(IssueSecuritySchemeTmpl)
+	ProjectRoleTmplPath          = tmplPath(ProjectRoleTmpl)
+	GroupTmplPath                = tmplPath(GroupTmpl)
+	PriorityTmplPath             = tmplPath(PriorityTmpl)
+	ResolutionTmplPath           = tmplPath(ResolutionTmpl)
+	IssueLinkTypeTmplPath        = tmplPath(IssueLinkTypeTmpl)
+	ProjectComponentTmplPath     = tmplPath(ProjectComponentTmpl)
+	ProjectVersionTmplPath       = tmplPath(ProjectVersionTmpl)
+	FilterTmplPath               = tmplPath(FilterTmpl)
+	DashboardTmplPath            = tmplPath(DashboardTmpl)
+	BoardTmplPath                = tmplPath(BoardTmpl)
+	WebhookTmplPath              = tmplPath(WebhookTmpl)
+	AnnouncementBannerTmplPath   = tmplPath(AnnouncementBannerTmpl)
+	ApplicationPropertyTmplPath  = tmplPath(ApplicationPropertyTmpl)
 )
 
 // Work type identifiers.
@@ -238,6 +238,366 @@ func GetFieldResCfg(t *testing.T, data FieldTemplateData) string {
 	return buf.String()
 }
 
+// GetWorkflowStatusCfg generates a Terraform workflow status resource configuration.
+func GetWorkflowStatusCfg(t *testing.T, cfg WorkflowStatusTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(WorkflowStatusTmpl).ParseFiles(WorkflowStatusTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetWorkflowStatusesDsCfg generates a workflow status resource plus a jira_workflow_statuses data source filtering on it.
+func GetWorkflowStatusesDsCfg(t *testing.T, status WorkflowStatusTmplCfg, categoryFilter string) string {
+	t.Helper()
+	tmpl, err := template.New(DataWorkflowStatusesTmpl).ParseFiles(DataWorkflowStatusesTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	cfg := DataWorkflowStatusesCfg{
+		StatusResource: GetWorkflowStatusCfg(t, status),
+		StatusCategory: categoryFilter,
+	}
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetWorkflowCfg generates two workflow statuses and a jira_workflow resource connecting them.
+func GetWorkflowCfg(t *testing.T, cfg WorkflowTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(WorkflowTmpl).ParseFiles(WorkflowTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetWorkflowSchemeCfg generates a work type and a jira_workflow_scheme resource optionally mapping it.
+func GetWorkflowSchemeCfg(t *testing.T, cfg WorkflowSchemeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(WorkflowSchemeTmpl).ParseFiles(WorkflowSchemeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetIssueTypeSchemeCfg generates two work types and a jira_issue_type_scheme listing them in the configured order.
+func GetIssueTypeSchemeCfg(t *testing.T, cfg IssueTypeSchemeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(IssueTypeSchemeTmpl).ParseFiles(IssueTypeSchemeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetScreenCfg generates a jira_screen with one jira_screen_tab laying out system fields.
+func GetScreenCfg(t *testing.T, cfg ScreenTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ScreenTmpl).ParseFiles(ScreenTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetScreenSchemeCfg generates two screens, a jira_screen_scheme using them and a jira_issue_type_screen_scheme
+// mapping a work type to it.
+func GetScreenSchemeCfg(t *testing.T, cfg ScreenSchemeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ScreenSchemeTmpl).ParseFiles(ScreenSchemeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetFieldContextCfg generates a jira_field with a jira_field_context and, for cascadingselect fields, a
+// jira_field_context_options managing its options.
+func GetFieldContextCfg(t *testing.T, cfg FieldContextTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(FieldContextTmpl).ParseFiles(FieldContextTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetFieldConfigurationCfg generates two jira_field_configuration resources and a jira_field_configuration_scheme
+// using them.
+func GetFieldConfigurationCfg(t *testing.T, cfg FieldConfigurationTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(FieldConfigurationTmpl).ParseFiles(FieldConfigurationTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetPermissionSchemeCfg generates a jira_permission_scheme with two grants, plus a third when ExtraGrant is set.
+func GetPermissionSchemeCfg(t *testing.T, cfg PermissionSchemeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(PermissionSchemeTmpl).ParseFiles(PermissionSchemeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetNotificationSchemeCfg generates a jira_notification_scheme, in its updated form when Updated is set.
+func GetNotificationSchemeCfg(t *testing.T, cfg NotificationSchemeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(NotificationSchemeTmpl).ParseFiles(NotificationSchemeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetIssueSecuritySchemeCfg generates a jira_issue_security_scheme with two levels, in their updated form when Updated is set.
+func GetIssueSecuritySchemeCfg(t *testing.T, cfg IssueSecuritySchemeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(IssueSecuritySchemeTmpl).ParseFiles(IssueSecuritySchemeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetProjectRoleCfg generates a project with a jira_project_role and the project lead assigned to it through jira_project_role_actors.
+func GetProjectRoleCfg(t *testing.T, cfg ProjectRoleTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ProjectRoleTmpl).ParseFiles(ProjectRoleTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetGroupCfg generates a jira_group with one user added through jira_group_membership.
+func GetGroupCfg(t *testing.T, cfg GroupTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(GroupTmpl).ParseFiles(GroupTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetPriorityCfg generates two jira_priority resources and a jira_priority_scheme ordering them.
+func GetPriorityCfg(t *testing.T, cfg PriorityTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(PriorityTmpl).ParseFiles(PriorityTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetResolutionCfg generates two jira_resolution resources, the first replaced by the second when deleted.
+func GetResolutionCfg(t *testing.T, cfg ResolutionTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ResolutionTmpl).ParseFiles(ResolutionTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetIssueLinkTypeCfg generates a jira_issue_link_type resource and a jira_issue_link_types data source filtered to it.
+func GetIssueLinkTypeCfg(t *testing.T, cfg IssueLinkTypeTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(IssueLinkTypeTmpl).ParseFiles(IssueLinkTypeTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetProjectComponentCfg generates a software project and a jira_project_component in it.
+func GetProjectComponentCfg(t *testing.T, cfg ProjectComponentTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ProjectComponentTmpl).ParseFiles(ProjectComponentTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetProjectVersionCfg generates a software project with two jira_project_version resources, the second receiving the
+// unfixed work items of the first when it is released.
+func GetProjectVersionCfg(t *testing.T, cfg ProjectVersionTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ProjectVersionTmpl).ParseFiles(ProjectVersionTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetFilterCfg generates a software project, a group and a project role, and a jira_filter shared with them.
+func GetFilterCfg(t *testing.T, cfg FilterTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(FilterTmpl).ParseFiles(FilterTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetDashboardCfg generates a software project with a filter, and a jira_dashboard shared with the project that shows
+// the filter.
+func GetDashboardCfg(t *testing.T, cfg DashboardTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(DashboardTmpl).ParseFiles(DashboardTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetBoardCfg generates a software project with a filter, a jira_board on the filter located in the project, and a
+// jira_boards data source listing the boards of the project.
+func GetBoardCfg(t *testing.T, cfg BoardTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(BoardTmpl).ParseFiles(BoardTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetWebhookCfg generates a jira_webhook subscribed to issue events.
+func GetWebhookCfg(t *testing.T, cfg WebhookTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(WebhookTmpl).ParseFiles(WebhookTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetAnnouncementBannerCfg generates the jira_announcement_banner of the site.
+func GetAnnouncementBannerCfg(t *testing.T, cfg AnnouncementBannerTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(AnnouncementBannerTmpl).ParseFiles(AnnouncementBannerTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// GetApplicationPropertyCfg generates a jira_application_property setting one property.
+func GetApplicationPropertyCfg(t *testing.T, cfg ApplicationPropertyTmplCfg) string {
+	t.Helper()
+	tmpl, err := template.New(ApplicationPropertyTmpl).ParseFiles(ApplicationPropertyTmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
 // RandString provides a simple deterministic-ish suffix for names when acctest isn't required.
 // We prefer acctest.RandomWithPrefix elsewhere; here we keep it self-contained.
 func RandString(n int) string {
@@ -69,3 +69,223 @@ type FieldTemplateData struct {
 	FieldType   string
 	Description string
 }
+
+// WorkflowStatusTmplCfg holds the values rendered into the workflow_status template.
+type WorkflowStatusTmplCfg struct {
+	Name           string
+	StatusCategory string
+	Description    string
+}
+
+// DataWorkflowStatusesCfg holds the values rendered into the data.workflow_statuses template.
+type DataWorkflowStatusesCfg struct {
+	StatusResource string
+	StatusCategory string
+}
+
+// WorkflowTmplCfg holds the values rendered into the workflow template.
+type WorkflowTmplCfg struct {
+	Name           string
+	StatusName     string
+	Description    string
+	TransitionName string
+}
+
+// WorkflowSchemeTmplCfg holds the values rendered into the workflow_scheme template.
+type WorkflowSchemeTmplCfg struct {
+	Name         string
+	Description  string
+	WorkTypeName string
+	MapWorkType  bool
+}
+
+// IssueTypeSchemeTmplCfg holds the values rendered into the issue_type_scheme template.
+type IssueTypeSchemeTmplCfg struct {
+	Name         string
+	Description  string
+	WorkTypeName string
+	// Reverse lists the second work type first and makes it the default.
+	Reverse bool
+}
+
+// ScreenTmplCfg holds the values rendered into the screen template.
+type ScreenTmplCfg struct {
+	Name        string
+	Description string
+	TabName     string
+	// Reverse places the tab first and lists its fields in reverse order.
+	Reverse bool
+}
+
+// ScreenSchemeTmplCfg holds the values rendered into the screen_scheme template.
+type ScreenSchemeTmplCfg struct {
+	Name         string
+	Description  string
+	WorkTypeName string
+	// SplitScreens uses the second screen for creating work items and maps the work type to the screen scheme.
+	SplitScreens bool
+}
+
+// FieldContextTmplCfg holds the values rendered into the field_context template.
+type FieldContextTmplCfg struct {
+	Name         string
+	Description  string
+	FieldType    string
+	WorkTypeName string
+	DefaultValue string
+	// ScopeToWorkType limits the context to the work type instead of any work type.
+	ScopeToWorkType bool
+	// Reverse lists the cascadingselect options in reverse order, disables the first one and moves the default.
+	Reverse bool
+}
+
+// FieldConfigurationTmplCfg holds the values rendered into the field_configuration template.
+type FieldConfigurationTmplCfg struct {
+	Name         string
+	Description  string
+	WorkTypeName string
+	// ManageCustomField adds the custom field to the managed fields of the field configuration.
+	ManageCustomField bool
+	// MapWorkType maps the work type to the alternate field configuration in the scheme.
+	MapWorkType bool
+}
+
+// PermissionSchemeTmplCfg holds the values rendered into the permission_scheme template.
+type PermissionSchemeTmplCfg struct {
+	Name        string
+	Description string
+	// ExtraGrant adds an application role grant to the two base grants.
+	ExtraGrant bool
+}
+
+// NotificationSchemeTmplCfg holds the values rendered into the notification_scheme template.
+type NotificationSchemeTmplCfg struct {
+	Name        string
+	Description string
+	// Updated swaps the watchers recipient of the issue created event for a custom field recipient and adds the
+	// issue updated event.
+	Updated bool
+}
+
+// IssueSecuritySchemeTmplCfg holds the values rendered into the issue_security_scheme template.
+type IssueSecuritySchemeTmplCfg struct {
+	Name        string
+	Description string
+	// Updated swaps a member of the internal level and makes the restricted level the default instead.
+	Updated bool
+}
+
+// ProjectRoleTmplCfg holds the values rendered into the project_role template.
+type ProjectRoleTmplCfg struct {
+	ProjectKey    string
+	Name          string
+	Description   string
+	LeadAccountID string
+	// Mode sets the mode of the role actors; empty leaves the default.
+	Mode string
+}
+
+// GroupTmplCfg holds the values rendered into the group template.
+type GroupTmplCfg struct {
+	Name      string
+	AccountID string
+	// Mode sets the mode of the group membership; empty leaves the default.
+	Mode string
+}
+
+// PriorityTmplCfg holds the values rendered into the priority template.
+type PriorityTmplCfg struct {
+	Name string
+	// Reversed lists the low priority before the high one in the scheme.
+	Reversed bool
+}
+
+// ResolutionTmplCfg holds the values rendered into the resolution template.
+type ResolutionTmplCfg struct {
+	Name        string
+	Description string
+}
+
+// IssueLinkTypeTmplCfg holds the values rendered into the issue link type template.
+type IssueLinkTypeTmplCfg struct {
+	Name    string
+	Inward  string
+	Outward string
+}
+
+// ProjectComponentTmplCfg holds the values rendered into the project component template.
+type ProjectComponentTmplCfg struct {
+	ProjectKey    string
+	Name          string
+	Description   string
+	LeadAccountID string
+	// ComponentLead sets the component lead to LeadAccountID and assigns its work items to the lead.
+	ComponentLead bool
+}
+
+// ProjectVersionTmplCfg holds the values rendered into the project version template.
+type ProjectVersionTmplCfg struct {
+	ProjectKey    string
+	Name          string
+	LeadAccountID string
+	// StartDate and ReleaseDate are omitted when empty.
+	StartDate   string
+	ReleaseDate string
+	Released    bool
+}
+
+// FilterTmplCfg holds the values rendered into the filter template.
+type FilterTmplCfg struct {
+	ProjectKey    string
+	Name          string
+	LeadAccountID string
+	Description   string
+	Favourite     bool
+	// Updated shares the filter with the project role instead of the group and drops the edit permissions.
+	Updated bool
+}
+
+// DashboardTmplCfg holds the values rendered into the dashboard template.
+type DashboardTmplCfg struct {
+	ProjectKey    string
+	Name          string
+	LeadAccountID string
+	Description   string
+	// Updated switches to a three-column layout, moves the filter gadget to the new column, changes its properties
+	// and replaces the activity stream gadget with a text gadget.
+	Updated bool
+}
+
+// BoardTmplCfg holds the values rendered into the board template.
+type BoardTmplCfg struct {
+	ProjectKey    string
+	Name          string
+	LeadAccountID string
+	// Type is the board type; scrum boards also get EstimationFieldID when it is set.
+	Type              string
+	EstimationFieldID string
+}
+
+// WebhookTmplCfg holds the values rendered into the webhook template.
+type WebhookTmplCfg struct {
+	Name      string
+	JQLFilter string
+	Secret    string
+	Enabled   bool
+	// Updated subscribes to comment events as well and excludes the body.
+	Updated bool
+}
+
+// AnnouncementBannerTmplCfg holds the values rendered into the announcement banner template.
+type AnnouncementBannerTmplCfg struct {
+	Message     string
+	Enabled     bool
+	Dismissible bool
+	Visibility  string
+}
+
+// ApplicationPropertyTmplCfg holds the values rendered into the application property template.
+type ApplicationPropertyTmplCfg struct {
+	Key   string
+	Value string
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*userDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*userDataSource)(nil)
+
+// userSearchPageSize is the page size used when paging through the user search endpoint.
+const userSearchPageSize = 100
+
+// NewUserDataSource returns the Terraform data source implementation for jira_user (lookup by account ID, email
+// or display name).
+func NewUserDataSource() datasource.DataSource { return &userDataSource{} }
+
+type userDataSource struct {
+	ServiceClient
+	userService        jira.UserConnector
+	userSearchService  jira.UserSearchConnector
+	emailRedactionMode string
+}
+
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a single Jira user by account ID, email address or exact display name, for example to set `lead_account_id` on `jira_project`.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The account ID of the user. Exactly one of account_id, email_address or display_name must be set.",
+			},
+			"email_address": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The email address of the user, matched case-insensitively and kept as configured. Exactly one of account_id, email_address or display_name must be set. " +
+					"Null when looked up otherwise and the user's profile visibility settings hide the email.",
+			},
+			"display_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The display name of the user, matched exactly. Exactly one of account_id, email_address or display_name must be set; the lookup fails if several users share the name.",
+			},
+			"account_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The account type: `atlassian` for people, `app` for apps and bots, or `customer` for service management customers.",
+			},
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the account is active.",
+			},
+		},
+	}
+}
+
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.userService = provider.client.User
+	d.userSearchService = provider.client.User.Search
+	d.emailRedactionMode = provider.emailRedactionMode
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+// searchUsers pages through the users matching query; Jira matches it against display names and email addresses.
+func (d *userDataSource) searchUsers(ctx context.Context, query string) ([]*models.UserScheme, *models.ResponseScheme, error) {
+	var (
+		users []*models.UserScheme
+		rs    *models.ResponseScheme
+	)
+	for startAt := 0; ; {
+		page, pageRS, err := d.userSearchService.Do(ctx, "", query, startAt, userSearchPageSize)
+		rs = pageRS
+		if err != nil {
+			return nil, rs, err
+		}
+		users = append(users, page...)
+		if len(page) < userSearchPageSize {
+			return users, rs, nil
+		}
+		startAt += len(page)
+	}
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data userModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, email, displayName := data.AccountID.ValueString(), data.EmailAddress.ValueString(), data.DisplayName.ValueString()
+	set := 0
+	for _, v := range []string{accountID, email, displayName} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid configuration for jira_user data source",
+			"Exactly one of 'account_id', 'email_address' or 'display_name' must be set to lookup a user.",
+		)
+		resp.Diagnostics.AddAttributeError(path.Root("account_id"), "Exactly one lookup attribute required", "Set only one of 'account_id', 'email_address' or 'display_name'.")
+		return
+	}
+
+	var user *models.UserScheme
+	switch {
+	case accountID != "":
+		u, apiResp, err := d.userService.Get(ctx, accountID, nil)
+		if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "get user", apiResp, err, &resp.Diagnostics, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return
+		}
+		user = u
+	case email != "":
+		var diags diag.Diagnostics
+		user = d.findUnique(ctx, email, fmt.Sprintf("email address %s", email), func(u *models.UserScheme) bool {
+			return strings.EqualFold(u.EmailAddress, email)
+		}, &diags)
+		resp.Diagnostics.Append(sanitizeEmailInDiags(diags, email, d.emailRedactionMode)...)
+	default:
+		user = d.findUnique(ctx, displayName, fmt.Sprintf("display name %q", displayName), func(u *models.UserScheme) bool {
+			return u.DisplayName == displayName
+		}, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(mapUserToModel(ctx, user, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The email is matched case-insensitively; keeping the configured casing avoids a diff against configuration.
+	if email != "" {
+		data.EmailAddress = types.StringValue(email)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findUnique searches users by query and returns the single one accepted by match, adding an error when none or
+// several match.
+func (d *userDataSource) findUnique(ctx context.Context, query, lookup string, match func(*models.UserScheme) bool, diags *diag.Diagnostics) *models.UserScheme {
+	users, apiResp, err := d.searchUsers(ctx, query)
+	if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "search users", apiResp, err, diags, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+		return nil
+	}
+	var matches []*models.UserScheme
+	var accountIDs []string
+	for _, u := range users {
+		if u != nil && match(u) {
+			matches = append(matches, u)
+			accountIDs = append(accountIDs, u.AccountID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		diags.AddError("User not found", fmt.Sprintf("No Jira user with %s exists, or it is not visible to the provider's credentials.", lookup))
+		return nil
+	case 1:
+		return matches[0]
+	default:
+		diags.AddError(
+			"Multiple users found",
+			fmt.Sprintf("%d Jira users match %s (account IDs: %s). Look the user up by account_id instead.", len(matches), lookup, strings.Join(accountIDs, ", ")),
+		)
+		return nil
+	}
+}
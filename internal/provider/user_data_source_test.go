@@ -0,0 +1,79 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccUserDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	accountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "jira_user" "by_id" {
+  account_id = %q
+}
+
+data "jira_user" "by_name" {
+  display_name = data.jira_user.by_id.display_name
+}
+`, accountID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.jira_user.by_id", tfjsonpath.New("account_type"), knownvalue.StringExact("atlassian")),
+					statecheck.ExpectKnownValue("data.jira_user.by_id", tfjsonpath.New("active"), knownvalue.Bool(true)),
+					statecheck.CompareValuePairs("data.jira_user.by_name", tfjsonpath.New("account_id"), "data.jira_user.by_id", tfjsonpath.New("account_id"), compare.ValuesSame()),
+				},
+			},
+			{
+				// The default email_redaction_mode fully redacts the email in the error.
+				Config:      `data "jira_user" "missing" { email_address = "tf-acc-nobody@example.invalid" }`,
+				ExpectError: regexp.MustCompile(`No Jira user with email address \[REDACTED_EMAIL\]`),
+			},
+		},
+	})
+}
+
+func TestSanitizeEmailInDiags(t *testing.T) {
+	email := "jane.doe+jira@example.com"
+	var diags diag.Diagnostics
+	diags.AddError("search users failed", "GET rest/api/3/user/search?query=jane.doe%2Bjira%40example.com for "+email)
+	diags.AddAttributeWarning(path.Root("email_address"), "note", "nothing to redact")
+
+	got := sanitizeEmailInDiags(diags, email, "mask")
+	if len(got) != 2 || !got.HasError() {
+		t.Fatalf("expected one error and one warning, got %v", got)
+	}
+	detail := got[0].Detail()
+	if strings.Contains(detail, "jane.doe") {
+		t.Fatalf("expected email to be sanitized, got %q", detail)
+	}
+	if want := "query=a****@example.com for a****@example.com"; !strings.Contains(detail, want) {
+		t.Fatalf("expected %q in %q", want, detail)
+	}
+	if got[1].Detail() != "nothing to redact" {
+		t.Fatalf("expected warning to be unchanged, got %q", got[1].Detail())
+	}
+	if dp, ok := got[1].(diag.DiagnosticWithPath); !ok || !dp.Path().Equal(path.Root("email_address")) {
+		t.Fatalf("expected the warning to keep its attribute path, got %v", got[1])
+	}
+}
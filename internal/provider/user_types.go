@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// userModel models a Jira user as exposed by the jira_user data source and the users map of jira_users.
+type userModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	DisplayName  types.String `tfsdk:"display_name"`
+	AccountType  types.String `tfsdk:"account_type"`
+	Active       types.Bool   `tfsdk:"active"`
+}
+
+func (m *userModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"account_id":    types.StringType,
+		"email_address": types.StringType,
+		"display_name":  types.StringType,
+		"account_type":  types.StringType,
+		"active":        types.BoolType,
+	}
+}
+
+// mapUserToModel maps a Jira user into the data source model. The email address is null when the user's profile
+// visibility settings hide it.
+func mapUserToModel(_ context.Context, api *models.UserScheme, st *userModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no user payload to map into state.")
+		return diags
+	}
+	*st = userModel{
+		AccountID:    types.StringValue(api.AccountID),
+		EmailAddress: stringOrNull(api.EmailAddress),
+		DisplayName:  types.StringValue(api.DisplayName),
+		AccountType:  types.StringValue(api.AccountType),
+		Active:       types.BoolValue(api.Active),
+	}
+	return diags
+}
+
+// sanitizeEmailInDiags rewrites diagnostics so that email appears only in its sanitized form, following the
+// provider's email_redaction_mode, and keeps their attribute paths. Jira error responses and request URLs may echo
+// the email being searched for, plain or query-escaped.
+func sanitizeEmailInDiags(diags diag.Diagnostics, email, mode string) diag.Diagnostics {
+	if email == "" {
+		return diags
+	}
+	redacted := sanitizeEmail(email, mode)
+	replacer := strings.NewReplacer(email, redacted, url.QueryEscape(email), redacted)
+	out := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		summary := replacer.Replace(d.Summary())
+		detail := replacer.Replace(d.Detail())
+		var sanitized diag.Diagnostic = diag.NewWarningDiagnostic(summary, detail)
+		if d.Severity() == diag.SeverityError {
+			sanitized = diag.NewErrorDiagnostic(summary, detail)
+		}
+		if dp, ok := d.(diag.DiagnosticWithPath); ok {
+			sanitized = diag.WithPath(dp.Path(), sanitized)
+		}
+		out.Append(sanitized)
+	}
+	return out
+}
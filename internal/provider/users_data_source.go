@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*usersDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*usersDataSource)(nil)
+
+// NewUsersDataSource returns the Terraform data source implementation for jira_users (query search; pagination).
+func NewUsersDataSource() datasource.DataSource { return &usersDataSource{} }
+
+type usersDataSource struct {
+	ServiceClient
+	userSearchService  jira.UserSearchConnector
+	emailRedactionMode string
+}
+
+type usersDataSourceModel struct {
+	// Inputs
+	Query       types.String `tfsdk:"query"`
+	AccountType types.String `tfsdk:"account_type"`
+
+	// Outputs
+	Users types.Map `tfsdk:"users"`
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Search Jira users by display name or email address. Results are keyed by account ID.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "A query string matched against the display name and email address of users (prefix match, case insensitive).",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"account_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return users of this account type: `atlassian`, `app` or `customer`.",
+				Validators:          []validator.String{stringvalidator.OneOf("atlassian", "app", "customer")},
+			},
+			"users": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of users keyed by account ID. Each value includes account_id, email_address, display_name, account_type and active.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The account ID of the user.",
+						},
+						"email_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email address of the user; null when the user's profile visibility settings hide it.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display name of the user.",
+						},
+						"account_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The account type: `atlassian`, `app` or `customer`.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the account is active.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.userSearchService = provider.client.User.Search
+	d.emailRedactionMode = provider.emailRedactionMode
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	query := data.Query.ValueString()
+	accountType := data.AccountType.ValueString()
+
+	// Users have no resource of their own, and so no CRUD runner to carry a typed list method.
+	objMap, listDiags := doListToMapCore(ctx, ListHooks[*models.UserScheme, userModel]{
+		ListPage: func(ctx context.Context, startAt, max int) ([]*models.UserScheme, bool, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			users, apiResp, err := d.userSearchService.Do(ctx, "", query, startAt, max)
+			if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "search users", apiResp, err, &diags, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+				return nil, true, diags
+			}
+			return users, len(users) < max, diags
+		},
+		Filter: func(_ context.Context, u *models.UserScheme) bool {
+			return u != nil && (accountType == "" || u.AccountType == accountType)
+		},
+		KeyOf: func(u *models.UserScheme) string {
+			return u.AccountID
+		},
+		MapToOut: func(ctx context.Context, u *models.UserScheme) (userModel, diag.Diagnostics) {
+			var m userModel
+			diags := mapUserToModel(ctx, u, &m)
+			return m, diags
+		},
+		AttrTypes: (&userModel{}).AttributeTypes,
+	}, ListOptions{RespectContext: true})
+	// The query may be an email address, which must not appear in diagnostics unsanitized.
+	if strings.Contains(query, "@") {
+		listDiags = sanitizeEmailInDiags(listDiags, query, d.emailRedactionMode)
+	}
+	resp.Diagnostics.Append(listDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mDiag diag.Diagnostics
+	data.Users, mDiag = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: (&userModel{}).AttributeTypes()}, objMap)
+	if mDiag.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("users"),
+			"Failed to build users map",
+			fmt.Sprintf("Could not encode %d users into state. See diagnostics for details.", len(objMap)),
+		)
+		resp.Diagnostics.Append(mDiag...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
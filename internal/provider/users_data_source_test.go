@@ -0,0 +1,47 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	accountID := testhelpers.GetTestProjLeadAcctIdFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "jira_user" "lead" {
+  account_id = %q
+}
+
+data "jira_users" "search" {
+  query        = data.jira_user.lead.display_name
+  account_type = "atlassian"
+}
+`, accountID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"data.jira_users.search", tfjsonpath.New("users").AtMapKey(accountID).AtMapKey("display_name"),
+						"data.jira_user.lead", tfjsonpath.New("display_name"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*webhookResource)(nil)
+var _ resource.ResourceWithConfigure = (*webhookResource)(nil)
+var _ resource.ResourceWithImportState = (*webhookResource)(nil)
+
+// NewWebhookResource returns the Terraform resource implementation for jira_webhook.
+func NewWebhookResource() resource.Resource { return &webhookResource{} }
+
+type webhookResource struct {
+	ServiceClient
+	crudRunner CRUDRunner[webhookResourceModel, *webhookPayload, *webhookAPIModel]
+}
+
+func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira admin webhook, which calls a URL when the selected events happen. Requires the Administer Jira global permission.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the webhook. Automatically generated by Jira when the webhook is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the webhook.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL Jira sends the events to. It may contain variables such as `${issue.key}`, which Jira fills in for each event.",
+				Validators:          []validator.String{stringvalidator.RegexMatches(webhookURLRegex, "must be an http or https URL")},
+			},
+			"events": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The events that trigger the webhook, such as `jira:issue_created`, `jira:issue_updated` or `comment_created`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"jql_filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A JQL query that limits issue related events to matching issues. Other events are not filtered.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"exclude_body": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether Jira sends an empty request instead of the event details. Defaults to `false`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the webhook is called. Defaults to `true`.",
+			},
+			"secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "A secret Jira signs each request with, in the `X-Hub-Signature` header. Jira never returns the " +
+					"secret, so changes made outside Terraform are not detected. Removing it replaces the webhook.",
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+						},
+						"Jira keeps a secret once set, so removing it replaces the webhook.",
+						"Jira keeps a secret once set, so removing it replaces the webhook.",
+					),
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt the admin webhook endpoints. Requests are sent directly (see webhookPayload).
+func (r *webhookResource) createWebhook(ctx context.Context, p *webhookPayload) (*webhookAPIModel, *models.ResponseScheme, error) {
+	var created webhookAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodPost, "rest/webhooks/1.0/webhook", p, &created)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &created, rs, nil
+}
+
+func (r *webhookResource) getWebhook(ctx context.Context, id string) (*webhookAPIModel, *models.ResponseScheme, error) {
+	var webhook webhookAPIModel
+	rs, err := callJira(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", id), nil, &webhook)
+	if err != nil {
+		return nil, rs, err
+	}
+	return &webhook, rs, nil
+}
+
+func (r *webhookResource) updateWebhook(ctx context.Context, id string, p *webhookPayload) (*webhookAPIModel, *models.ResponseScheme, error) {
+	if rs, err := callJira(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", id), p, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getWebhook(ctx, id)
+}
+
+func (r *webhookResource) deleteWebhook(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return callJira(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", id), nil, nil)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *webhookResource) hooks() CRUDHooks[webhookResourceModel, *webhookPayload, *webhookAPIModel] {
+	return CRUDHooks[webhookResourceModel, *webhookPayload, *webhookAPIModel]{
+		BuildPayload: func(ctx context.Context, st *webhookResourceModel) (*webhookPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var events []string
+			diags.Append(st.Events.ElementsAs(ctx, &events, false)...)
+			filters := map[string]string{webhookJQLFilterKey: st.JQLFilter.ValueString()}
+			return &webhookPayload{
+				Name:        st.Name.ValueString(),
+				URL:         st.URL.ValueString(),
+				Events:      events,
+				Filters:     filters,
+				ExcludeBody: st.ExcludeBody.ValueBool(),
+				Enabled:     st.Enabled.ValueBool(),
+				Secret:      st.Secret.ValueString(),
+			}, diags
+		},
+		APICreate:               r.createWebhook,
+		APIRead:                 r.getWebhook,
+		APIUpdate:               r.updateWebhook,
+		APIDelete:               r.deleteWebhook,
+		ExtractID:               func(st *webhookResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapWebhookToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *webhookResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *webhookResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *webhookResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *webhookResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *webhookResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *webhookResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *webhookResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *webhookResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *webhookResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
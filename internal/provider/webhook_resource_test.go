@@ -0,0 +1,67 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWebhookResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "jira_webhook.test"
+	cfg := testhelpers.WebhookTmplCfg{
+		Name:    acctest.RandomWithPrefix(accPrefixWebhook),
+		Secret:  acctest.RandString(32),
+		Enabled: true,
+	}
+	updated := cfg
+	updated.JQLFilter = "issuetype = Bug"
+	updated.Enabled = false
+	updated.Updated = true
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetWebhookCfg(t, cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("events"), knownvalue.SetSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("jql_filter"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("exclude_body"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("secret"), knownvalue.StringExact(cfg.Secret)),
+				},
+			},
+			{
+				Config: testhelpers.GetWebhookCfg(t, updated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(resourceName, tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("events"), knownvalue.SetSizeExact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("jql_filter"), knownvalue.StringExact("issuetype = Bug")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("exclude_body"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+				// Jira never returns the secret.
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
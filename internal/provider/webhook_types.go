@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"path"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookJQLFilterKey is the key of the JQL filter in the filters of a webhook; it only applies to issue events.
+const webhookJQLFilterKey = "issue-related-events-section"
+
+// webhookURLRegex matches the http and https URLs Jira accepts as webhook targets.
+var webhookURLRegex = regexp.MustCompile(`^https?://[^\s/]+`)
+
+// webhookResourceModel models the Terraform schema/state for jira_webhook.
+type webhookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	Events      types.Set    `tfsdk:"events"`
+	JQLFilter   types.String `tfsdk:"jql_filter"`
+	ExcludeBody types.Bool   `tfsdk:"exclude_body"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Secret      types.String `tfsdk:"secret"`
+}
+
+// webhookPayload carries the planned webhook for create/update. go-atlassian only covers the webhooks of Connect and
+// OAuth apps, so the admin webhook API is called directly. The secret is only sent when set.
+type webhookPayload struct {
+	Name        string            `json:"name"`
+	URL         string            `json:"url"`
+	Events      []string          `json:"events"`
+	Filters     map[string]string `json:"filters"`
+	ExcludeBody bool              `json:"excludeBody"`
+	Enabled     bool              `json:"enabled"`
+	Secret      string            `json:"secret,omitempty"`
+}
+
+// webhookAPIModel is the webhook as returned by Jira, which identifies it by its self URL only. The secret is never
+// returned.
+type webhookAPIModel struct {
+	Self        string            `json:"self"`
+	Name        string            `json:"name"`
+	URL         string            `json:"url"`
+	Events      []string          `json:"events"`
+	Filters     map[string]string `json:"filters"`
+	ExcludeBody bool              `json:"excludeBody"`
+	Enabled     bool              `json:"enabled"`
+}
+
+// webhookID returns the ID of a webhook, the last segment of its self URL.
+func webhookID(self string) string {
+	if self == "" {
+		return ""
+	}
+	return path.Base(self)
+}
+
+// mapWebhookToModel centralizes mapping for the webhook resource and matches CRUDHooks MapToState signature. The
+// secret is write-only in Jira and is carried over from st.
+func mapWebhookToModel(ctx context.Context, api *webhookAPIModel, st *webhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no webhook payload to map into state.")
+		return diags
+	}
+	events, d := types.SetValueFrom(ctx, types.StringType, api.Events)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	*st = webhookResourceModel{
+		ID:          types.StringValue(webhookID(api.Self)),
+		Name:        types.StringValue(api.Name),
+		URL:         types.StringValue(api.URL),
+		Events:      events,
+		JQLFilter:   stringOrNull(api.Filters[webhookJQLFilterKey]),
+		ExcludeBody: types.BoolValue(api.ExcludeBody),
+		Enabled:     types.BoolValue(api.Enabled),
+		Secret:      st.Secret,
+	}
+	return diags
+}
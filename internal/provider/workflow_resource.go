@@ -0,0 +1,517 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*workflowResource)(nil)
+var _ resource.ResourceWithConfigure = (*workflowResource)(nil)
+var _ resource.ResourceWithImportState = (*workflowResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*workflowResource)(nil)
+
+// NewWorkflowResource returns the Terraform resource implementation for jira_workflow.
+func NewWorkflowResource() resource.Resource { return &workflowResource{} }
+
+type workflowResource struct {
+	ServiceClient
+	workflowService jira.WorkflowConnector
+	crudRunner      CRUDRunner[workflowResourceModel, *models.WorkflowCreatesPayload, *models.JiraWorkflowScheme]
+}
+
+func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.workflowService = provider.client.Workflow
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func ruleListSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description + " Omit the attribute instead of setting an empty list.",
+		Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"rule_key": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The rule key, for example `system:check-permission-validator`.",
+					Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+				"parameters": schema.MapAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					MarkdownDescription: "Rule parameters as string key/value pairs.",
+					Validators:          []validator.Map{mapvalidator.SizeAtLeast(1)},
+				},
+			},
+		},
+	}
+}
+
+func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira workflow through the bulk workflow APIs. Statuses are referenced by ID (see `jira_workflow_status`) and transitions are identified by a stable, user-assigned ID so they can be renamed in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the workflow. Automatically generated by Jira when the workflow is created.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The name of the workflow. Jira does not allow renaming workflows through the bulk APIs, so changing the name forces a new resource.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the workflow.",
+			},
+			"scope_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(workflowStatusScopeGlobal),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The scope of the workflow. `GLOBAL` (default) for company-managed workflows; `PROJECT` scopes it to a single team-managed project. Changing the scope forces a new resource.",
+				Validators:          []validator.String{stringvalidator.OneOf(workflowStatusScopeGlobal, workflowStatusScopeProject)},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The ID of the project that owns the workflow. Required when `scope_type` is `PROJECT`. Changing the project forces a new resource.",
+			},
+			"status_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "IDs of the statuses used by the workflow.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"transitions": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The workflow transitions. Exactly one transition must be of type `INITIAL`.",
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The stable identifier of the transition within the workflow (for example `1`, `11`, `21`). Transitions are matched by this ID, so renaming a transition updates it in place.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the transition.",
+							Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "A description of the transition.",
+						},
+						"type": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(workflowTransitionTypeDirected),
+							MarkdownDescription: "The transition type. Valid values: `INITIAL`, `GLOBAL`, `DIRECTED` (default).",
+							Validators:          []validator.String{stringvalidator.OneOf(workflowTransitionTypes...)},
+						},
+						"from_status_ids": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "IDs of the statuses the transition starts from. Required for `DIRECTED` transitions and not allowed otherwise.",
+							Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+						},
+						"to_status_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "ID of the status the transition leads to.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"screen_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the screen displayed during the transition.",
+						},
+						"triggers":       ruleListSchema("Triggers that execute the transition automatically."),
+						"conditions":     ruleListSchema("Conditions that must all pass for the transition to be available."),
+						"validators":     ruleListSchema("Validators that check the input before the transition completes."),
+						"post_functions": ruleListSchema("Post functions executed after the transition completes, in order."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *workflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data workflowResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ScopeType.IsUnknown() && !data.ProjectID.IsUnknown() {
+		scope := data.ScopeType.ValueString()
+		if data.ScopeType.IsNull() {
+			scope = workflowStatusScopeGlobal
+		}
+		switch {
+		case scope == workflowStatusScopeProject && data.ProjectID.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_id"),
+				"Missing project_id",
+				"The 'project_id' attribute is required when scope_type is \"PROJECT\". Set it to the ID of the team-managed project that owns the workflow.",
+			)
+		case scope == workflowStatusScopeGlobal && !data.ProjectID.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_id"),
+				"Unexpected project_id",
+				"The 'project_id' attribute can only be set when scope_type is \"PROJECT\". Remove it or set scope_type = \"PROJECT\".",
+			)
+		}
+	}
+
+	if data.Transitions.IsUnknown() || listHasUnknown(data.Transitions) {
+		return
+	}
+	transitions, d := data.transitionModels(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Status references are only checked when the whole status set is known.
+	var statuses map[string]struct{}
+	if !data.StatusIDs.IsNull() && !data.StatusIDs.IsUnknown() {
+		var ids []string
+		resp.Diagnostics.Append(data.StatusIDs.ElementsAs(ctx, &ids, false)...)
+		statuses = make(map[string]struct{}, len(ids))
+		for _, id := range ids {
+			statuses[id] = struct{}{}
+		}
+	}
+	checkStatus := func(p path.Path, id types.String) {
+		if statuses == nil || id.IsNull() || id.IsUnknown() {
+			return
+		}
+		if _, ok := statuses[id.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Unknown status reference",
+				fmt.Sprintf("Status %q is not listed in 'status_ids'. Add it to the workflow statuses or reference a different status.", id.ValueString()),
+			)
+		}
+	}
+
+	seen := map[string]struct{}{}
+	initial := 0
+	for i, t := range transitions {
+		p := path.Root("transitions").AtListIndex(i)
+		if !t.ID.IsUnknown() && !t.ID.IsNull() {
+			if _, dup := seen[t.ID.ValueString()]; dup {
+				resp.Diagnostics.AddAttributeError(
+					p.AtName("id"),
+					"Duplicate transition id",
+					fmt.Sprintf("Transition id %q is used more than once. Transition ids must be unique within a workflow.", t.ID.ValueString()),
+				)
+			}
+			seen[t.ID.ValueString()] = struct{}{}
+		}
+		checkStatus(p.AtName("to_status_id"), t.ToStatusID)
+
+		if t.Type.IsUnknown() || t.FromStatusIDs.IsUnknown() {
+			continue
+		}
+		kind := t.Type.ValueString()
+		if t.Type.IsNull() {
+			kind = workflowTransitionTypeDirected
+		}
+		if kind == workflowTransitionTypeInitial {
+			initial++
+		}
+		switch {
+		case kind == workflowTransitionTypeDirected && t.FromStatusIDs.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				p.AtName("from_status_ids"),
+				"Missing from_status_ids",
+				"DIRECTED transitions must list at least one status in 'from_status_ids'.",
+			)
+		case kind != workflowTransitionTypeDirected && !t.FromStatusIDs.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				p.AtName("from_status_ids"),
+				"Unexpected from_status_ids",
+				fmt.Sprintf("%s transitions cannot set 'from_status_ids'. Remove it or change the transition type to \"DIRECTED\".", kind),
+			)
+		case !t.FromStatusIDs.IsNull():
+			var from []string
+			resp.Diagnostics.Append(t.FromStatusIDs.ElementsAs(ctx, &from, false)...)
+			sort.Strings(from)
+			for _, f := range from {
+				checkStatus(p.AtName("from_status_ids"), types.StringValue(f))
+			}
+		}
+	}
+	if initial != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("transitions"),
+			"Invalid initial transition",
+			fmt.Sprintf("A workflow must have exactly one transition of type \"INITIAL\"; found %d.", initial),
+		)
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+
+// createWorkflow creates the workflow and reads it back so transitions include their links.
+func (r *workflowResource) createWorkflow(ctx context.Context, p *models.WorkflowCreatesPayload) (*models.JiraWorkflowScheme, *models.ResponseScheme, error) {
+	created, rs, err := r.workflowService.Creates(ctx, p)
+	if err != nil {
+		return nil, rs, err
+	}
+	if created == nil || len(created.Workflows) == 0 || created.Workflows[0] == nil {
+		return nil, rs, fmt.Errorf("jira returned no workflow after create")
+	}
+	return r.getWorkflow(ctx, created.Workflows[0].ID)
+}
+
+// searchWorkflow returns the single workflow matching the criteria. Jira answers unknown IDs and
+// names with an empty list rather than a 404, so an empty result is translated into a Not Found
+// response to keep DoRead's removal semantics.
+func (r *workflowResource) searchWorkflow(ctx context.Context, criteria *models.WorkflowSearchCriteria, match func(*models.JiraWorkflowScheme) bool, label string) (*models.JiraWorkflowScheme, *models.ResponseScheme, error) {
+	found, rs, err := r.workflowService.Search(ctx, criteria, nil, true)
+	if err != nil {
+		return nil, rs, err
+	}
+	if found != nil {
+		for _, w := range found.Workflows {
+			if w != nil && match(w) {
+				return w, rs, nil
+			}
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("workflow %s not found", label)
+}
+
+func (r *workflowResource) getWorkflow(ctx context.Context, id string) (*models.JiraWorkflowScheme, *models.ResponseScheme, error) {
+	return r.searchWorkflow(ctx, &models.WorkflowSearchCriteria{WorkflowIDs: []string{id}},
+		func(w *models.JiraWorkflowScheme) bool { return w.ID == id }, id)
+}
+
+// getWorkflowByName backs import, which addresses workflows by their (unique) name.
+func (r *workflowResource) getWorkflowByName(ctx context.Context, name string) (*models.JiraWorkflowScheme, *models.ResponseScheme, error) {
+	return r.searchWorkflow(ctx, &models.WorkflowSearchCriteria{WorkflowNames: []string{name}},
+		func(w *models.JiraWorkflowScheme) bool { return w.Name == name }, fmt.Sprintf("%q", name))
+}
+
+// updateWorkflow converts the create payload into a bulk update. Jira requires the current document
+// version for optimistic locking, so the workflow is read first.
+func (r *workflowResource) updateWorkflow(ctx context.Context, id string, p *models.WorkflowCreatesPayload) (*models.JiraWorkflowScheme, *models.ResponseScheme, error) {
+	current, rs, err := r.getWorkflow(ctx, id)
+	if err != nil {
+		return nil, rs, err
+	}
+	if len(p.Workflows) == 0 {
+		return nil, rs, fmt.Errorf("no workflow definition to update")
+	}
+	def := p.Workflows[0]
+	u := &models.WorkflowUpdatesPayloadScheme{
+		Statuses: p.Statuses,
+		Workflows: []*models.WorkflowUpdateScheme{
+			{
+				ID:          id,
+				Description: def.Description,
+				Version:     current.Version,
+				Statuses:    def.Statuses,
+				Transitions: def.Transitions,
+			},
+		},
+	}
+	if _, rs, err = r.workflowService.Updates(ctx, u, nil); err != nil {
+		return nil, rs, err
+	}
+	return r.getWorkflow(ctx, id)
+}
+
+func (r *workflowResource) deleteWorkflow(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return r.workflowService.Delete(ctx, id)
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *workflowResource) hooks() CRUDHooks[workflowResourceModel, *models.WorkflowCreatesPayload, *models.JiraWorkflowScheme] {
+	return CRUDHooks[workflowResourceModel, *models.WorkflowCreatesPayload, *models.JiraWorkflowScheme]{
+		BuildPayload: func(ctx context.Context, st *workflowResourceModel) (*models.WorkflowCreatesPayload, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			scope := &models.WorkflowScopeScheme{Type: workflowStatusScopeGlobal}
+			if st.ScopeType.ValueString() == workflowStatusScopeProject {
+				scope = &models.WorkflowScopeScheme{
+					Type:    workflowStatusScopeProject,
+					Project: &models.WorkflowScopeProjectScheme{ID: st.ProjectID.ValueString()},
+				}
+			}
+
+			var statusIDs []string
+			diags.Append(st.StatusIDs.ElementsAs(ctx, &statusIDs, false)...)
+			transitions, d := buildWorkflowTransitions(ctx, st)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			sort.Strings(statusIDs)
+
+			// Existing statuses are referenced by using their ID as the status reference.
+			p := &models.WorkflowCreatesPayload{Scope: scope}
+			def := &models.WorkflowCreateScheme{
+				Name:        st.Name.ValueString(),
+				Description: st.Description.ValueString(),
+				Transitions: transitions,
+			}
+			for _, id := range statusIDs {
+				p.AddStatus(&models.WorkflowStatusUpdateScheme{ID: id, StatusReference: id})
+				def.AddStatus(&models.StatusLayoutUpdateScheme{StatusReference: id})
+			}
+			if err := p.AddWorkflow(def); err != nil {
+				diags.AddError("Invalid workflow definition", err.Error())
+				return nil, diags
+			}
+			return p, diags
+		},
+		APICreate:               r.createWorkflow,
+		APIRead:                 r.getWorkflow,
+		APIUpdate:               r.updateWorkflow,
+		APIDelete:               r.deleteWorkflow,
+		ExtractID:               func(st *workflowResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapWorkflowSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *workflowResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *workflowResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *workflowResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *workflowResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports a workflow by name. The runner is rebuilt with a name-based read hook;
+// subsequent refreshes use the workflow ID stored in state.
+func (r *workflowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	hooks := r.hooks()
+	hooks.APIRead = r.getWorkflowByName
+	diags := NewCRUDRunner(hooks).DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *workflowResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
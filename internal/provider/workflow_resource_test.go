@@ -0,0 +1,132 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWorkflowResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_workflow.test"
+	name := acctest.RandomWithPrefix(accPrefixWorkflow)
+	statusName := acctest.RandomWithPrefix(accPrefixWorkflowStatus)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetWorkflowCfg(t, testhelpers.WorkflowTmplCfg{
+					Name:           name,
+					StatusName:     statusName,
+					TransitionName: "Finish",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("scope_type"), knownvalue.StringExact("GLOBAL")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("status_ids"), knownvalue.SetSizeExact(2)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("transitions").AtSliceIndex(1).AtMapKey("name"), knownvalue.StringExact("Finish")),
+				},
+			},
+			{
+				// Renaming a transition keeps its ID and must update the workflow in place.
+				Config: testhelpers.GetWorkflowCfg(t, testhelpers.WorkflowTmplCfg{
+					Name:           name,
+					StatusName:     statusName,
+					Description:    "Updated workflow description",
+					TransitionName: "Complete",
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated workflow description")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("transitions").AtSliceIndex(1).AtMapKey("id"), knownvalue.StringExact("11")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("transitions").AtSliceIndex(1).AtMapKey("name"), knownvalue.StringExact("Complete")),
+				},
+			},
+			{
+				ImportState:                          true,
+				ImportStateId:                        name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+				ResourceName:                         rName,
+			},
+		},
+	})
+}
+
+func TestAccWorkflowResource_negative(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing initial transition should fail validation", func(t *testing.T) {
+		cfg := fmt.Sprintf(`
+resource "jira_workflow" "test" {
+  name       = "%s"
+  status_ids = ["1", "3"]
+  transitions = [
+    {
+      id              = "11"
+      name            = "Start"
+      from_status_ids = ["1"]
+      to_status_id    = "3"
+    },
+  ]
+}
+`, acctest.RandomWithPrefix(accPrefixWorkflow))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      cfg,
+					ExpectError: regexp.MustCompile(`Invalid initial transition`),
+				},
+			},
+		})
+	})
+
+	t.Run("transition to unknown status should fail validation", func(t *testing.T) {
+		cfg := fmt.Sprintf(`
+resource "jira_workflow" "test" {
+  name       = "%s"
+  status_ids = ["1"]
+  transitions = [
+    {
+      id           = "1"
+      name         = "Create"
+      type         = "INITIAL"
+      to_status_id = "3"
+    },
+  ]
+}
+`, acctest.RandomWithPrefix(accPrefixWorkflow))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      cfg,
+					ExpectError: regexp.MustCompile(`Unknown status reference`),
+				},
+			},
+		})
+	})
+}
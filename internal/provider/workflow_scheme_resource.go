@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*workflowSchemeResource)(nil)
+var _ resource.ResourceWithConfigure = (*workflowSchemeResource)(nil)
+var _ resource.ResourceWithImportState = (*workflowSchemeResource)(nil)
+
+// NewWorkflowSchemeResource returns the Terraform resource implementation for jira_workflow_scheme.
+func NewWorkflowSchemeResource() resource.Resource { return &workflowSchemeResource{} }
+
+type workflowSchemeResource struct {
+	ServiceClient
+	schemeService jira.WorkflowSchemeConnector
+	crudRunner    CRUDRunner[workflowSchemeResourceModel, *models.WorkflowSchemePayloadScheme, *workflowSchemeAPIModel]
+}
+
+func (r *workflowSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_scheme"
+}
+
+func (r *workflowSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.schemeService = provider.client.Workflow.Scheme
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *workflowSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira workflow scheme, which maps work types to workflows. When the scheme is active on projects, changes are made to a draft scheme that is published automatically; the provider waits for the resulting Jira migration task to finish.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the workflow scheme. Automatically generated by Jira when the scheme is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the workflow scheme. Must be unique.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the workflow scheme.",
+			},
+			"default_workflow": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(systemWorkflowName),
+				MarkdownDescription: "The name of the workflow used for work types without an explicit mapping. Defaults to Jira's system workflow (`jira`) when omitted.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"work_type_workflows": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Map of work type ID (for example `jira_work_type.example.id`) to workflow name.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(numericIDRegex, "must be a numeric work type ID")),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *workflowSchemeResource) createScheme(ctx context.Context, p *models.WorkflowSchemePayloadScheme) (*workflowSchemeAPIModel, *models.ResponseScheme, error) {
+	created, rs, err := r.schemeService.Create(ctx, p)
+	if err != nil {
+		return nil, rs, err
+	}
+	api, err := newWorkflowSchemeAPIModel(created, rs)
+	return api, rs, err
+}
+
+func (r *workflowSchemeResource) getScheme(ctx context.Context, id string) (*workflowSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid workflow scheme id %q: %w", id, err)
+	}
+	scheme, rs, err := r.schemeService.Get(ctx, i, false)
+	if err != nil {
+		return nil, rs, err
+	}
+	api, err := newWorkflowSchemeAPIModel(scheme, rs)
+	return api, rs, err
+}
+
+// updateScheme lets Jira write to a draft when the scheme is active, then publishes that draft and waits
+// for the migration task so the refreshed scheme reflects the applied change.
+func (r *workflowSchemeResource) updateScheme(ctx context.Context, id string, p *models.WorkflowSchemePayloadScheme) (*workflowSchemeAPIModel, *models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid workflow scheme id %q: %w", id, err)
+	}
+	p.UpdateDraftIfNeeded = true
+	updated, rs, err := r.schemeService.Update(ctx, i, p)
+	if err != nil {
+		return nil, rs, err
+	}
+	if updated == nil || !updated.Draft {
+		api, err := newWorkflowSchemeAPIModel(updated, rs)
+		return api, rs, err
+	}
+	if rs, err := r.publishDraft(ctx, i); err != nil {
+		return nil, rs, err
+	}
+	return r.getScheme(ctx, id)
+}
+
+func (r *workflowSchemeResource) deleteScheme(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workflow scheme id %q: %w", id, err)
+	}
+	return r.schemeService.Delete(ctx, i)
+}
+
+// publishDraft publishes the draft of an active workflow scheme and waits for the issue migration task.
+// go-atlassian does not wrap this endpoint. Jira answers with 303 See Other pointing at the task, which
+// the HTTP client follows, so the decoded body is the task itself.
+func (r *workflowSchemeResource) publishDraft(ctx context.Context, id int) (*models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("rest/api/3/workflowscheme/%d/draft/publish", id)
+	task := new(models.TaskScheme)
+	rs, err := callJira(ctx, r.client, http.MethodPost, endpoint, map[string]any{"statusMappings": []any{}}, task)
+	if err != nil || task.ID == "" {
+		return rs, err
+	}
+	_, rs, err = waitForJiraTask(ctx, r.client, task.ID, "publish draft workflow scheme")
+	return rs, err
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *workflowSchemeResource) hooks() CRUDHooks[workflowSchemeResourceModel, *models.WorkflowSchemePayloadScheme, *workflowSchemeAPIModel] {
+	return CRUDHooks[workflowSchemeResourceModel, *models.WorkflowSchemePayloadScheme, *workflowSchemeAPIModel]{
+		BuildPayload: func(ctx context.Context, st *workflowSchemeResourceModel) (*models.WorkflowSchemePayloadScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			// Always send the mapping object so removed entries are cleared on update.
+			mappings := map[string]string{}
+			if !st.WorkTypeWorkflows.IsNull() && !st.WorkTypeWorkflows.IsUnknown() {
+				diags.Append(st.WorkTypeWorkflows.ElementsAs(ctx, &mappings, false)...)
+			}
+			p := &models.WorkflowSchemePayloadScheme{
+				Name:              st.Name.ValueString(),
+				Description:       st.Description.ValueString(),
+				IssueTypeMappings: mappings,
+			}
+			if !st.DefaultWorkflow.IsUnknown() {
+				p.DefaultWorkflow = st.DefaultWorkflow.ValueString()
+			}
+			return p, diags
+		},
+		APICreate:               r.createScheme,
+		APIRead:                 r.getScheme,
+		APIUpdate:               r.updateScheme,
+		APIDelete:               r.deleteScheme,
+		ExtractID:               func(st *workflowSchemeResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapWorkflowSchemeSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *workflowSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *workflowSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *workflowSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *workflowSchemeResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowSchemeResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *workflowSchemeResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSchemeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *workflowSchemeResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWorkflowSchemeResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_workflow_scheme.test"
+	name := acctest.RandomWithPrefix(accPrefixWorkflowScheme)
+	workTypeName := acctest.RandomWithPrefix(accPrefixWorkType)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetWorkflowSchemeCfg(t, testhelpers.WorkflowSchemeTmplCfg{
+					Name:         name,
+					WorkTypeName: workTypeName,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("default_workflow"), knownvalue.StringExact("jira")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_workflows"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testhelpers.GetWorkflowSchemeCfg(t, testhelpers.WorkflowSchemeTmplCfg{
+					Name:         name,
+					Description:  "Updated scheme description",
+					WorkTypeName: workTypeName,
+					MapWorkType:  true,
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated scheme description")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("work_type_workflows"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// systemWorkflowName is the name of Jira's read-only system workflow, the default workflow of a new scheme. It is the
+// planned default_workflow when none is configured, so removing the attribute restores it.
+const systemWorkflowName = "jira"
+
+// workflowSchemeResourceModel models the Terraform schema/state for jira_workflow_scheme.
+type workflowSchemeResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	DefaultWorkflow   types.String `tfsdk:"default_workflow"`
+	WorkTypeWorkflows types.Map    `tfsdk:"work_type_workflows"`
+}
+
+func (m *workflowSchemeResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                  types.StringType,
+		"name":                types.StringType,
+		"description":         types.StringType,
+		"default_workflow":    types.StringType,
+		"work_type_workflows": types.MapType{ElemType: types.StringType},
+	}
+}
+
+// workflowSchemeAPIModel wraps the go-atlassian workflow scheme model with the issue type mappings,
+// which Jira returns on every scheme response but models.WorkflowSchemeScheme does not decode.
+type workflowSchemeAPIModel struct {
+	*models.WorkflowSchemeScheme
+	IssueTypeMappings map[string]string
+}
+
+// newWorkflowSchemeAPIModel pairs a decoded scheme with the issueTypeMappings found in the raw response body.
+func newWorkflowSchemeAPIModel(scheme *models.WorkflowSchemeScheme, rs *models.ResponseScheme) (*workflowSchemeAPIModel, error) {
+	out := &workflowSchemeAPIModel{WorkflowSchemeScheme: scheme, IssueTypeMappings: map[string]string{}}
+	if rs == nil || rs.Bytes.Len() == 0 {
+		return out, nil
+	}
+	var raw struct {
+		IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+	}
+	if err := json.Unmarshal(rs.Bytes.Bytes(), &raw); err != nil {
+		return nil, err
+	}
+	for k, v := range raw.IssueTypeMappings {
+		out.IssueTypeMappings[k] = v
+	}
+	return out, nil
+}
+
+// mapWorkflowSchemeSchemeToModel centralizes mapping for the workflow scheme resource and matches CRUDHooks MapToState signature.
+func mapWorkflowSchemeSchemeToModel(ctx context.Context, api *workflowSchemeAPIModel, st *workflowSchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil || api.WorkflowSchemeScheme == nil {
+		diags.AddError("Empty API model", "The Jira API returned no workflow scheme payload to map into state.")
+		return diags
+	}
+
+	mappings := types.MapNull(types.StringType)
+	if len(api.IssueTypeMappings) > 0 {
+		var d diag.Diagnostics
+		mappings, d = types.MapValueFrom(ctx, types.StringType, api.IssueTypeMappings)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*st = workflowSchemeResourceModel{
+		ID:                types.StringValue(strconv.Itoa(api.ID)),
+		Name:              types.StringValue(api.Name),
+		Description:       stringOrNull(api.Description),
+		DefaultWorkflow:   types.StringValue(api.DefaultWorkflow),
+		WorkTypeWorkflows: mappings,
+	}
+	return diags
+}
@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ resource.Resource = (*workflowStatusResource)(nil)
+var _ resource.ResourceWithConfigure = (*workflowStatusResource)(nil)
+var _ resource.ResourceWithImportState = (*workflowStatusResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*workflowStatusResource)(nil)
+
+// NewWorkflowStatusResource returns the Terraform resource implementation for jira_workflow_status.
+func NewWorkflowStatusResource() resource.Resource { return &workflowStatusResource{} }
+
+type workflowStatusResource struct {
+	ServiceClient
+	statusService jira.WorkflowStatusConnector
+	crudRunner    CRUDRunner[workflowStatusResourceModel, *models.WorkflowStatusPayloadScheme, *models.WorkflowStatusDetailScheme]
+}
+
+func (r *workflowStatusResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_status"
+}
+
+func (r *workflowStatusResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.client
+	r.statusService = provider.client.Workflow.Status
+	r.crudRunner = NewCRUDRunner(r.hooks())
+	r.providerTimeouts = provider.providerTimeouts
+}
+
+func (r *workflowStatusResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data workflowStatusResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ScopeType.IsUnknown() || data.ProjectID.IsUnknown() {
+		return
+	}
+
+	scope := data.ScopeType.ValueString()
+	if data.ScopeType.IsNull() {
+		scope = workflowStatusScopeGlobal
+	}
+	switch {
+	case scope == workflowStatusScopeProject && data.ProjectID.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_id"),
+			"Missing project_id",
+			"The 'project_id' attribute is required when scope_type is \"PROJECT\". Set it to the ID of the team-managed project that owns the status.",
+		)
+	case scope == workflowStatusScopeGlobal && !data.ProjectID.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_id"),
+			"Unexpected project_id",
+			"The 'project_id' attribute can only be set when scope_type is \"PROJECT\". Remove it or set scope_type = \"PROJECT\".",
+		)
+	}
+}
+
+func (r *workflowStatusResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jira workflow status. Statuses represent the steps of a workflow and can be shared globally or scoped to a single team-managed project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The unique identifier of the workflow status. Automatically generated by Jira when the status is created.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The display name of the workflow status.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 255)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the workflow status.",
+			},
+			"status_category": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The status category. Valid values: `TODO`, `IN_PROGRESS`, `DONE`.",
+				Validators:          []validator.String{stringvalidator.OneOf(workflowStatusCategories...)},
+			},
+			"scope_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(workflowStatusScopeGlobal),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The scope of the status. `GLOBAL` (default) makes the status available to company-managed workflows; `PROJECT` scopes it to a single team-managed project. Changing the scope forces a new resource.",
+				Validators:          []validator.String{stringvalidator.OneOf(workflowStatusScopeGlobal, workflowStatusScopeProject)},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The ID of the project that owns the status. Required when `scope_type` is `PROJECT`. Changing the project forces a new resource.",
+			},
+		},
+	}
+}
+
+// Wrapper functions to adapt go-atlassian signatures for this resource.
+func (r *workflowStatusResource) createStatus(ctx context.Context, p *models.WorkflowStatusPayloadScheme) (*models.WorkflowStatusDetailScheme, *models.ResponseScheme, error) {
+	created, rs, err := r.statusService.Create(ctx, p)
+	if err != nil {
+		return nil, rs, err
+	}
+	if len(created) == 0 {
+		return nil, rs, fmt.Errorf("jira returned no workflow status after create")
+	}
+	return created[0], rs, nil
+}
+
+// getStatus reads a single status through the bulk /statuses endpoint. Jira answers unknown IDs with
+// an empty list rather than a 404, so an empty result is translated into a Not Found response to keep
+// DoRead's removal semantics.
+func (r *workflowStatusResource) getStatus(ctx context.Context, id string) (*models.WorkflowStatusDetailScheme, *models.ResponseScheme, error) {
+	statuses, rs, err := r.statusService.Gets(ctx, []string{id}, nil)
+	if err != nil {
+		return nil, rs, err
+	}
+	for _, s := range statuses {
+		if s != nil && s.ID == id {
+			return s, rs, nil
+		}
+	}
+	return nil, &models.ResponseScheme{Code: http.StatusNotFound}, fmt.Errorf("workflow status %s not found", id)
+}
+
+// updateStatus sends the mutable attributes (scope cannot change) and reads back the status for state mapping.
+func (r *workflowStatusResource) updateStatus(ctx context.Context, id string, p *models.WorkflowStatusPayloadScheme) (*models.WorkflowStatusDetailScheme, *models.ResponseScheme, error) {
+	u := &models.WorkflowStatusPayloadScheme{Statuses: make([]*models.WorkflowStatusNodeScheme, 0, len(p.Statuses))}
+	for _, s := range p.Statuses {
+		u.Statuses = append(u.Statuses, &models.WorkflowStatusNodeScheme{
+			ID:             id,
+			Name:           s.Name,
+			StatusCategory: s.StatusCategory,
+			Description:    s.Description,
+		})
+	}
+	rs, err := r.statusService.Update(ctx, u)
+	if err != nil {
+		return nil, rs, err
+	}
+	return r.getStatus(ctx, id)
+}
+
+func (r *workflowStatusResource) deleteStatus(ctx context.Context, id string) (*models.ResponseScheme, error) {
+	return r.statusService.Delete(ctx, []string{id})
+}
+
+// hooks returns the CRUD hooks for the generic runner.
+func (r *workflowStatusResource) hooks() CRUDHooks[workflowStatusResourceModel, *models.WorkflowStatusPayloadScheme, *models.WorkflowStatusDetailScheme] {
+	return CRUDHooks[workflowStatusResourceModel, *models.WorkflowStatusPayloadScheme, *models.WorkflowStatusDetailScheme]{
+		BuildPayload: func(ctx context.Context, st *workflowStatusResourceModel) (*models.WorkflowStatusPayloadScheme, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			scope := &models.WorkflowStatusScopeScheme{Type: workflowStatusScopeGlobal}
+			if st.ScopeType.ValueString() == workflowStatusScopeProject {
+				scope = &models.WorkflowStatusScopeScheme{
+					Type:    workflowStatusScopeProject,
+					Project: &models.WorkflowStatusProjectScheme{ID: st.ProjectID.ValueString()},
+				}
+			}
+			p := &models.WorkflowStatusPayloadScheme{
+				Statuses: []*models.WorkflowStatusNodeScheme{
+					{
+						Name:           st.Name.ValueString(),
+						StatusCategory: st.StatusCategory.ValueString(),
+						Description:    st.Description.ValueString(),
+					},
+				},
+				Scope: scope,
+			}
+			return p, diags
+		},
+		APICreate:               r.createStatus,
+		APIRead:                 r.getStatus,
+		APIUpdate:               r.updateStatus,
+		APIDelete:               r.deleteStatus,
+		ExtractID:               func(st *workflowStatusResourceModel) string { return st.ID.ValueString() },
+		MapToState:              mapWorkflowStatusSchemeToModel,
+		TreatDelete404AsSuccess: true,
+	}
+}
+
+func (r *workflowStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Create)
+	defer cancel()
+
+	diags := r.crudRunner.DoCreate(
+		ctx,
+		func(ctx context.Context, dst *workflowStatusResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowStatusResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoRead(
+		ctx,
+		func(ctx context.Context, dst *workflowStatusResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowStatusResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		func(ctx context.Context) { resp.State.RemoveResource(ctx) },
+		ensureWith(&resp.Diagnostics),
+		HTTPStatusFromScheme,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Update)
+	defer cancel()
+
+	diags := r.crudRunner.DoUpdate(
+		ctx,
+		func(ctx context.Context, dst *workflowStatusResourceModel) diag.Diagnostics {
+			return req.Plan.Get(ctx, dst)
+		},
+		func(ctx context.Context, src *workflowStatusResourceModel) diag.Diagnostics {
+			return resp.State.Set(ctx, src)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Delete)
+	defer cancel()
+
+	diags := r.crudRunner.DoDelete(
+		ctx,
+		func(ctx context.Context, dst *workflowStatusResourceModel) diag.Diagnostics {
+			return req.State.Get(ctx, dst)
+		},
+		ensureWith(&resp.Diagnostics),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowStatusResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ctx, cancel := withTimeout(ctx, r.providerTimeouts.Read)
+	defer cancel()
+
+	diags := r.crudRunner.DoImport(
+		ctx,
+		request.ID,
+		func(ctx context.Context, src *workflowStatusResourceModel) diag.Diagnostics {
+			return response.State.Set(ctx, src)
+		},
+		ensureWith(&response.Diagnostics),
+	)
+	response.Diagnostics.Append(diags...)
+}
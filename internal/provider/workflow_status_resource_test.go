@@ -0,0 +1,85 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWorkflowStatusResource_basic(t *testing.T) {
+	t.Parallel()
+
+	rName := "jira_workflow_status.test"
+	name := acctest.RandomWithPrefix(accPrefixWorkflowStatus)
+	renamed := name + "-renamed"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.GetWorkflowStatusCfg(t, testhelpers.WorkflowStatusTmplCfg{
+					Name:           name,
+					StatusCategory: "TODO",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("status_category"), knownvalue.StringExact("TODO")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("scope_type"), knownvalue.StringExact("GLOBAL")),
+				},
+			},
+			{
+				Config: testhelpers.GetWorkflowStatusCfg(t, testhelpers.WorkflowStatusTmplCfg{
+					Name:           renamed,
+					StatusCategory: "IN_PROGRESS",
+					Description:    "Updated status description",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("name"), knownvalue.StringExact(renamed)),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("status_category"), knownvalue.StringExact("IN_PROGRESS")),
+					statecheck.ExpectKnownValue(rName, tfjsonpath.New("description"), knownvalue.StringExact("Updated status description")),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ResourceName:    rName,
+			},
+		},
+	})
+}
+
+func TestAccWorkflowStatusResource_negative(t *testing.T) {
+	t.Parallel()
+
+	t.Run("project scope without project_id should fail validation", func(t *testing.T) {
+		cfg := fmt.Sprintf(`
+resource "jira_workflow_status" "test" {
+  name            = "%s"
+  status_category = "DONE"
+  scope_type      = "PROJECT"
+}
+`, acctest.RandomWithPrefix(accPrefixWorkflowStatus))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      cfg,
+					ExpectError: regexp.MustCompile(`Missing project_id`),
+				},
+			},
+		})
+	})
+}
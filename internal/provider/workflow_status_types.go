@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Workflow status scope types accepted by the Jira /statuses API.
+const (
+	workflowStatusScopeGlobal  = "GLOBAL"
+	workflowStatusScopeProject = "PROJECT"
+)
+
+// workflowStatusCategories lists the status category keys accepted by the Jira /statuses API.
+var workflowStatusCategories = []string{"TODO", "IN_PROGRESS", "DONE"}
+
+// workflowStatusResourceModel models the Terraform schema/state for jira_workflow_status.
+type workflowStatusResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	StatusCategory types.String `tfsdk:"status_category"`
+	ScopeType      types.String `tfsdk:"scope_type"`
+	ProjectID      types.String `tfsdk:"project_id"`
+}
+
+func (m *workflowStatusResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"name":            types.StringType,
+		"description":     types.StringType,
+		"status_category": types.StringType,
+		"scope_type":      types.StringType,
+		"project_id":      types.StringType,
+	}
+}
+
+// mapWorkflowStatusSchemeToModel centralizes mapping for resources/data sources and matches CRUDHooks MapToState signature.
+func mapWorkflowStatusSchemeToModel(_ context.Context, api *models.WorkflowStatusDetailScheme, st *workflowStatusResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no workflow status payload to map into state.")
+		return diags
+	}
+	scopeType := workflowStatusScopeGlobal
+	projectID := types.StringNull()
+	if api.Scope != nil {
+		if api.Scope.Type != "" {
+			scopeType = api.Scope.Type
+		}
+		if api.Scope.Project != nil {
+			projectID = stringOrNull(api.Scope.Project.ID)
+		}
+	}
+	*st = workflowStatusResourceModel{
+		ID:             types.StringValue(api.ID),
+		Name:           types.StringValue(api.Name),
+		Description:    stringOrNull(api.Description),
+		StatusCategory: types.StringValue(api.StatusCategory),
+		ScopeType:      types.StringValue(scopeType),
+		ProjectID:      projectID,
+	}
+	return diags
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*workflowStatusesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*workflowStatusesDataSource)(nil)
+
+// NewWorkflowStatusesDataSource returns the Terraform data source implementation for jira_workflow_statuses.
+func NewWorkflowStatusesDataSource() datasource.DataSource { return &workflowStatusesDataSource{} }
+
+var emptyWorkflowStatusModel = workflowStatusResourceModel{}
+
+type workflowStatusesDataSource struct {
+	ServiceClient
+	statusService jira.WorkflowStatusConnector
+}
+
+type workflowStatusesDataSourceModel struct {
+	Names          types.List   `tfsdk:"names"`
+	StatusCategory types.String `tfsdk:"status_category"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Statuses       types.Map    `tfsdk:"statuses"`
+}
+
+func (d *workflowStatusesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_statuses"
+}
+
+func (d *workflowStatusesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List Jira workflow statuses with optional filtering by names, status category, or owning project. Results are returned as a map keyed by status ID for stability across renames.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Filter by status names (case-insensitive, exact match). If omitted, statuses of any name are returned.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"status_category": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by status category. Valid values: `TODO`, `IN_PROGRESS`, `DONE`. Applied server-side.",
+				Validators:          []validator.String{stringvalidator.OneOf(workflowStatusCategories...)},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by the project that owns project-scoped statuses. Applied server-side.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"statuses": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of workflow statuses keyed by status ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workflow status.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display name of the workflow status.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of the workflow status.",
+						},
+						"status_category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status category (`TODO`, `IN_PROGRESS`, or `DONE`).",
+						},
+						"scope_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The scope of the status (`GLOBAL` or `PROJECT`).",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the owning project for project-scoped statuses.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workflowStatusesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*JiraProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected JiraProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = provider.client
+	d.statusService = provider.client.Workflow.Status
+	d.providerTimeouts = provider.providerTimeouts
+}
+
+func (d *workflowStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, d.providerTimeouts.Read)
+	defer cancel()
+
+	var data workflowStatusesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, deferNames := getKnownStrings(ctx, data.Names, "names", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || deferNames {
+		return
+	}
+	if data.StatusCategory.IsUnknown() || data.ProjectID.IsUnknown() {
+		return
+	}
+
+	// Category and project filters are supported by /statuses/search; names are matched client-side
+	// because the search string is a substring match.
+	opts := &models.WorkflowStatusSearchParams{
+		StatusCategory: data.StatusCategory.ValueString(),
+		ProjectID:      data.ProjectID.ValueString(),
+	}
+
+	nameFilter := map[string]struct{}{}
+	for _, n := range uniqueStrings(names) {
+		nameFilter[strings.ToLower(n)] = struct{}{}
+	}
+	foundNames := map[string]struct{}{}
+
+	listPage := func(ctx context.Context, startAt, max int) ([]*models.WorkflowStatusDetailScheme, bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		page, apiResp, err := d.statusService.Search(ctx, opts, startAt, max)
+		if !EnsureSuccessOrDiagFromSchemeWithOptions(ctx, "list workflow statuses", apiResp, err, &diags, &EnsureSuccessOrDiagOptions{IncludeBodySnippet: true}) {
+			return nil, true, diags
+		}
+		if page == nil {
+			return nil, true, diags
+		}
+		return page.Values, page.IsLast, diags
+	}
+
+	var runner CRUDRunner[workflowStatusResourceModel, *models.WorkflowStatusPayloadScheme, *models.WorkflowStatusDetailScheme]
+	objMap, mapDiags := runner.DoListWorkflowStatuses(ctx, ListHooks[*models.WorkflowStatusDetailScheme, workflowStatusResourceModel]{
+		ListPage: listPage,
+		Filter: func(ctx context.Context, s *models.WorkflowStatusDetailScheme) bool {
+			if len(nameFilter) == 0 {
+				return true
+			}
+			ln := strings.ToLower(s.Name)
+			if _, ok := nameFilter[ln]; ok {
+				foundNames[ln] = struct{}{}
+				return true
+			}
+			return false
+		},
+		KeyOf: func(s *models.WorkflowStatusDetailScheme) string {
+			return s.ID
+		},
+		MapToOut: func(ctx context.Context, s *models.WorkflowStatusDetailScheme) (workflowStatusResourceModel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var m workflowStatusResourceModel
+			diags.Append(mapWorkflowStatusSchemeToModel(ctx, s, &m)...)
+			return m, diags
+		},
+		AttrTypes: emptyWorkflowStatusModel.AttributeTypes,
+	})
+	resp.Diagnostics.Append(mapDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(nameFilter) > 0 {
+		var missing []string
+		for n := range nameFilter {
+			if _, ok := foundNames[n]; !ok {
+				missing = append(missing, n)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			resp.Diagnostics.AddWarning(
+				"Some requested workflow status names were not found",
+				fmt.Sprintf("The following names were not found in Jira: %v. They will be omitted from the result.", missing),
+			)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Statuses, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: emptyWorkflowStatusModel.AttributeTypes()}, objMap)
+	if diags.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("statuses"),
+			"Failed to build statuses map",
+			fmt.Sprintf("Could not encode %d workflow statuses into state. See diagnostics for details.", len(objMap)),
+		)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
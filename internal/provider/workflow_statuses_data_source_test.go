@@ -0,0 +1,55 @@
+// Copyright (c) DevOps Wiz
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-jira/internal/provider/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWorkflowStatusesDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	dsName := "data.jira_workflow_statuses.test"
+	status := testhelpers.WorkflowStatusTmplCfg{
+		Name:           acctest.RandomWithPrefix(accPrefixWorkflowStatus),
+		StatusCategory: "DONE",
+	}
+
+	t.Run("filter by name", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testhelpers.GetWorkflowStatusesDsCfg(t, status, ""),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(dsName, tfjsonpath.New("statuses"), knownvalue.MapSizeExact(1)),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("filter by name and non-matching category", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testhelpers.GetWorkflowStatusesDsCfg(t, status, "TODO"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(dsName, tfjsonpath.New("statuses"), knownvalue.MapSizeExact(0)),
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Transition types accepted by the bulk workflow APIs.
+const (
+	workflowTransitionTypeInitial  = "INITIAL"
+	workflowTransitionTypeGlobal   = "GLOBAL"
+	workflowTransitionTypeDirected = "DIRECTED"
+)
+
+// workflowTransitionTypes lists the transition types accepted by the bulk workflow APIs.
+var workflowTransitionTypes = []string{workflowTransitionTypeInitial, workflowTransitionTypeGlobal, workflowTransitionTypeDirected}
+
+// Rule key and parameter used by Jira to attach a screen to a transition.
+const (
+	workflowTransitionScreenRuleKey = "system:transition-screen"
+	workflowTransitionScreenParam   = "screenId"
+)
+
+// workflowConditionOperationAll combines top-level transition conditions with AND semantics.
+const workflowConditionOperationAll = "ALL"
+
+// workflowResourceModel models the Terraform schema/state for jira_workflow.
+type workflowResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ScopeType   types.String `tfsdk:"scope_type"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	StatusIDs   types.Set    `tfsdk:"status_ids"`
+	Transitions types.List   `tfsdk:"transitions"`
+}
+
+// workflowTransitionModel models a single element of the transitions list.
+type workflowTransitionModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	FromStatusIDs types.Set    `tfsdk:"from_status_ids"`
+	ToStatusID    types.String `tfsdk:"to_status_id"`
+	ScreenID      types.String `tfsdk:"screen_id"`
+	Triggers      types.List   `tfsdk:"triggers"`
+	Conditions    types.List   `tfsdk:"conditions"`
+	Validators    types.List   `tfsdk:"validators"`
+	PostFunctions types.List   `tfsdk:"post_functions"`
+}
+
+// workflowRuleModel models a condition, validator, post function or trigger attached to a transition.
+type workflowRuleModel struct {
+	RuleKey    types.String `tfsdk:"rule_key"`
+	Parameters types.Map    `tfsdk:"parameters"`
+}
+
+func (m *workflowRuleModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rule_key":   types.StringType,
+		"parameters": types.MapType{ElemType: types.StringType},
+	}
+}
+
+func (m *workflowTransitionModel) AttributeTypes() map[string]attr.Type {
+	ruleList := types.ListType{ElemType: types.ObjectType{AttrTypes: (&workflowRuleModel{}).AttributeTypes()}}
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"name":            types.StringType,
+		"description":     types.StringType,
+		"type":            types.StringType,
+		"from_status_ids": types.SetType{ElemType: types.StringType},
+		"to_status_id":    types.StringType,
+		"screen_id":       types.StringType,
+		"triggers":        ruleList,
+		"conditions":      ruleList,
+		"validators":      ruleList,
+		"post_functions":  ruleList,
+	}
+}
+
+func (m *workflowResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"scope_type":  types.StringType,
+		"project_id":  types.StringType,
+		"status_ids":  types.SetType{ElemType: types.StringType},
+		"transitions": types.ListType{ElemType: types.ObjectType{AttrTypes: (&workflowTransitionModel{}).AttributeTypes()}},
+	}
+}
+
+// transitionModels decodes the transitions list; null or unknown lists yield no elements.
+func (m *workflowResourceModel) transitionModels(ctx context.Context) ([]workflowTransitionModel, diag.Diagnostics) {
+	if m.Transitions.IsNull() || m.Transitions.IsUnknown() {
+		return nil, nil
+	}
+	var out []workflowTransitionModel
+	diags := m.Transitions.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+// buildWorkflowRules converts a Terraform rule list into API rule configurations.
+func buildWorkflowRules(ctx context.Context, l types.List) ([]*models.WorkflowRuleConfigurationScheme, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if l.IsNull() || l.IsUnknown() {
+		return nil, diags
+	}
+	var rules []workflowRuleModel
+	diags.Append(l.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	out := make([]*models.WorkflowRuleConfigurationScheme, 0, len(rules))
+	for _, rule := range rules {
+		cfg := &models.WorkflowRuleConfigurationScheme{RuleKey: rule.RuleKey.ValueString()}
+		if !rule.Parameters.IsNull() && !rule.Parameters.IsUnknown() {
+			params := map[string]string{}
+			diags.Append(rule.Parameters.ElementsAs(ctx, &params, false)...)
+			cfg.Parameters = make(map[string]any, len(params))
+			for k, v := range params {
+				cfg.Parameters[k] = v
+			}
+		}
+		out = append(out, cfg)
+	}
+	return out, diags
+}
+
+// buildWorkflowTransitions converts planned transitions into the DTOs shared by the bulk create and update payloads.
+func buildWorkflowTransitions(ctx context.Context, st *workflowResourceModel) ([]*models.TransitionUpdateDTOScheme, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	transitions, d := st.transitionModels(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := make([]*models.TransitionUpdateDTOScheme, 0, len(transitions))
+	for _, t := range transitions {
+		dto := &models.TransitionUpdateDTOScheme{
+			ID:                t.ID.ValueString(),
+			Name:              t.Name.ValueString(),
+			Description:       t.Description.ValueString(),
+			Type:              t.Type.ValueString(),
+			ToStatusReference: t.ToStatusID.ValueString(),
+		}
+		if !t.FromStatusIDs.IsNull() && !t.FromStatusIDs.IsUnknown() {
+			var from []string
+			diags.Append(t.FromStatusIDs.ElementsAs(ctx, &from, false)...)
+			sort.Strings(from)
+			for _, f := range from {
+				dto.Links = append(dto.Links, &models.WorkflowTransitionLinkScheme{FromStatusReference: f})
+			}
+		}
+		if !t.ScreenID.IsNull() && !t.ScreenID.IsUnknown() {
+			dto.TransitionScreen = &models.WorkflowRuleConfigurationScheme{
+				RuleKey:    workflowTransitionScreenRuleKey,
+				Parameters: map[string]any{workflowTransitionScreenParam: t.ScreenID.ValueString()},
+			}
+		}
+
+		var rd diag.Diagnostics
+		dto.Triggers, rd = buildWorkflowRules(ctx, t.Triggers)
+		diags.Append(rd...)
+		dto.Validators, rd = buildWorkflowRules(ctx, t.Validators)
+		diags.Append(rd...)
+		dto.Actions, rd = buildWorkflowRules(ctx, t.PostFunctions)
+		diags.Append(rd...)
+		conditions, rd := buildWorkflowRules(ctx, t.Conditions)
+		diags.Append(rd...)
+		if len(conditions) > 0 {
+			dto.Conditions = &models.ConditionGroupUpdateScheme{
+				Operation:  workflowConditionOperationAll,
+				Conditions: conditions,
+			}
+		}
+		out = append(out, dto)
+	}
+	return out, diags
+}
+
+// mapWorkflowRules converts API rule configurations into a Terraform list; empty rule lists map to null.
+func mapWorkflowRules(ctx context.Context, rules []*models.WorkflowRuleConfigurationScheme) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: (&workflowRuleModel{}).AttributeTypes()}
+	if len(rules) == 0 {
+		return types.ListNull(elemType), nil
+	}
+	out := make([]workflowRuleModel, 0, len(rules))
+	var diags diag.Diagnostics
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		m := workflowRuleModel{
+			RuleKey:    types.StringValue(rule.RuleKey),
+			Parameters: types.MapNull(types.StringType),
+		}
+		if len(rule.Parameters) > 0 {
+			params := make(map[string]string, len(rule.Parameters))
+			for k, v := range rule.Parameters {
+				params[k] = fmt.Sprint(v)
+			}
+			var d diag.Diagnostics
+			m.Parameters, d = types.MapValueFrom(ctx, types.StringType, params)
+			diags.Append(d...)
+		}
+		out = append(out, m)
+	}
+	l, d := types.ListValueFrom(ctx, elemType, out)
+	diags.Append(d...)
+	return l, diags
+}
+
+// mapWorkflowTransition converts an API transition into its Terraform model.
+func mapWorkflowTransition(ctx context.Context, t *models.WorkflowTransitionScheme) (workflowTransitionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	to := t.ToStatusReference
+	if to == "" && t.To != nil {
+		to = t.To.StatusReference
+	}
+	var from []string
+	for _, l := range t.Links {
+		if l != nil && l.FromStatusReference != "" {
+			from = append(from, l.FromStatusReference)
+		}
+	}
+	if len(from) == 0 {
+		for _, f := range t.From {
+			if f != nil && f.StatusReference != "" {
+				from = append(from, f.StatusReference)
+			}
+		}
+	}
+	screenID := types.StringNull()
+	if t.TransitionScreen != nil && t.TransitionScreen.Parameters != nil {
+		if v, ok := t.TransitionScreen.Parameters[workflowTransitionScreenParam]; ok && v != nil {
+			screenID = stringOrNull(fmt.Sprint(v))
+		}
+	}
+
+	m := workflowTransitionModel{
+		ID:          types.StringValue(t.ID),
+		Name:        types.StringValue(t.Name),
+		Description: stringOrNull(t.Description),
+		Type:        types.StringValue(t.Type),
+		ToStatusID:  types.StringValue(to),
+		ScreenID:    screenID,
+	}
+	var d diag.Diagnostics
+	m.FromStatusIDs, d = stringSetOrNull(ctx, uniqueStrings(from))
+	diags.Append(d...)
+	m.Triggers, d = mapWorkflowRules(ctx, t.Triggers)
+	diags.Append(d...)
+	m.Validators, d = mapWorkflowRules(ctx, t.Validators)
+	diags.Append(d...)
+	m.PostFunctions, d = mapWorkflowRules(ctx, t.Actions)
+	diags.Append(d...)
+	var conditions []*models.WorkflowRuleConfigurationScheme
+	if t.Conditions != nil {
+		conditions = t.Conditions.Conditions
+	}
+	m.Conditions, d = mapWorkflowRules(ctx, conditions)
+	diags.Append(d...)
+	return m, diags
+}
+
+// orderWorkflowTransitions orders API transitions by the IDs already present in state so that
+// Jira's response ordering does not produce spurious diffs; transitions unknown to state are
+// appended sorted by ID.
+func orderWorkflowTransitions(prior []workflowTransitionModel, api []*models.WorkflowTransitionScheme) []*models.WorkflowTransitionScheme {
+	byID := make(map[string]*models.WorkflowTransitionScheme, len(api))
+	for _, t := range api {
+		if t != nil {
+			byID[t.ID] = t
+		}
+	}
+	out := make([]*models.WorkflowTransitionScheme, 0, len(byID))
+	for _, p := range prior {
+		if t, ok := byID[p.ID.ValueString()]; ok {
+			out = append(out, t)
+			delete(byID, p.ID.ValueString())
+		}
+	}
+	rest := make([]*models.WorkflowTransitionScheme, 0, len(byID))
+	for _, t := range byID {
+		rest = append(rest, t)
+	}
+	sort.Slice(rest, func(i, j int) bool { return rest[i].ID < rest[j].ID })
+	return append(out, rest...)
+}
+
+// mapWorkflowSchemeToModel centralizes mapping for the workflow resource and matches CRUDHooks MapToState signature.
+// The incoming state is used only to preserve the configured transition order.
+func mapWorkflowSchemeToModel(ctx context.Context, api *models.JiraWorkflowScheme, st *workflowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if api == nil {
+		diags.AddError("Empty API model", "The Jira API returned no workflow payload to map into state.")
+		return diags
+	}
+
+	prior, d := st.transitionModels(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	scopeType := workflowStatusScopeGlobal
+	projectID := types.StringNull()
+	if api.Scope != nil {
+		if api.Scope.Type != "" {
+			scopeType = api.Scope.Type
+		}
+		if api.Scope.Project != nil {
+			projectID = stringOrNull(api.Scope.Project.ID)
+		}
+	}
+
+	statusIDs := make([]string, 0, len(api.Statuses))
+	for _, s := range api.Statuses {
+		if s != nil && s.StatusReference != "" {
+			statusIDs = append(statusIDs, s.StatusReference)
+		}
+	}
+
+	transitions := make([]workflowTransitionModel, 0, len(api.Transitions))
+	for _, t := range orderWorkflowTransitions(prior, api.Transitions) {
+		m, d := mapWorkflowTransition(ctx, t)
+		diags.Append(d...)
+		transitions = append(transitions, m)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	statusSet, d := stringSetOrNull(ctx, uniqueStrings(statusIDs))
+	diags.Append(d...)
+	transitionList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&workflowTransitionModel{}).AttributeTypes()}, transitions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*st = workflowResourceModel{
+		ID:          types.StringValue(api.ID),
+		Name:        types.StringValue(api.Name),
+		Description: stringOrNull(api.Description),
+		ScopeType:   types.StringValue(scopeType),
+		ProjectID:   projectID,
+		StatusIDs:   statusSet,
+		Transitions: transitionList,
+	}
+	return diags
+}